@@ -35,11 +35,11 @@ func HomePage() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = iconoir.CheckCircle.Render().Render(ctx, templ_7745c5c3_Buffer)
+		templ_7745c5c3_Err = iconoir.CheckCircle.Render(ctx, templ_7745c5c3_Buffer)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = iconoir.CheckCircleSolid.Render().Render(ctx, templ_7745c5c3_Buffer)
+		templ_7745c5c3_Err = iconoir.CheckCircleSolid.Render(ctx, templ_7745c5c3_Buffer)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -47,23 +47,23 @@ func HomePage() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = iconoir.Swimming.Config().SetSize(16).Render().Render(ctx, templ_7745c5c3_Buffer)
+		templ_7745c5c3_Err = iconoir.Swimming.Config().SetSize(16).Render(ctx, templ_7745c5c3_Buffer)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = iconoir.Swimming.Config().SetSize(20).Render().Render(ctx, templ_7745c5c3_Buffer)
+		templ_7745c5c3_Err = iconoir.Swimming.Config().SetSize(20).Render(ctx, templ_7745c5c3_Buffer)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = iconoir.Swimming.Render().Render(ctx, templ_7745c5c3_Buffer)
+		templ_7745c5c3_Err = iconoir.Swimming.Render(ctx, templ_7745c5c3_Buffer)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = iconoir.Swimming.Config().SetSize(32).Render().Render(ctx, templ_7745c5c3_Buffer)
+		templ_7745c5c3_Err = iconoir.Swimming.Config().SetSize(32).Render(ctx, templ_7745c5c3_Buffer)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = iconoir.Swimming.Render().Render(ctx, templ_7745c5c3_Buffer)
+		templ_7745c5c3_Err = iconoir.Swimming.Render(ctx, templ_7745c5c3_Buffer)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -71,11 +71,11 @@ func HomePage() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = iconoir.Chromecast.Config().SetColor("#22d3ee").Render().Render(ctx, templ_7745c5c3_Buffer)
+		templ_7745c5c3_Err = iconoir.Chromecast.Config().SetColor("#22d3ee").Render(ctx, templ_7745c5c3_Buffer)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = iconoir.ChatMinusInSolid.Config().SetColor("#2dd4bf").Render().Render(ctx, templ_7745c5c3_Buffer)
+		templ_7745c5c3_Err = iconoir.ChatMinusInSolid.Config().SetColor("#2dd4bf").Render(ctx, templ_7745c5c3_Buffer)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -0,0 +1,178 @@
+package templiconoir
+
+import (
+	"context"
+	"io"
+	"runtime"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+// Benchmarks of the render path, reporting the allocations per render.
+// Run with: go test -run '^$' -bench . -benchmem
+
+func benchmarkRender(b *testing.B, component templ.Component) {
+	b.Helper()
+	ctx := context.Background()
+
+	// Warm up the dataset and the buffer pool
+	if err := component.Render(ctx, io.Discard); err != nil {
+		b.Fatalf("unexpected error: %v", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := component.Render(ctx, io.Discard); err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+func BenchmarkRender_Default(b *testing.B) {
+	benchmarkRender(b, CheckCircle)
+}
+
+func BenchmarkRender_Configured(b *testing.B) {
+	benchmarkRender(b, CheckCircle.Config().
+		SetSize(32).
+		SetColor("#2dd4bf").
+		SetStrokeWidth("2").
+		SetAttrs(templ.Attributes{"class": "icon", "data-state": "done"}))
+}
+
+func BenchmarkRender_Labelled(b *testing.B) {
+	benchmarkRender(b, CheckCircle.Config().SetLabel("Done").SetDescription("The task is complete"))
+}
+
+func BenchmarkRender_ElementIDs(b *testing.B) {
+	benchmarkRender(b, Podcast)
+}
+
+func BenchmarkRender_Sprite(b *testing.B) {
+	benchmarkRender(b, CheckCircle.Config().SetRenderMode(RenderSprite))
+}
+
+func BenchmarkRender_Cached(b *testing.B) {
+	SetRenderCachePolicy(CacheLRU, 100)
+	b.Cleanup(func() {
+		SetRenderCachePolicy(CacheNone, 0)
+		ResetRenderCache()
+	})
+	benchmarkRender(b, CheckCircle.Config().
+		SetSize(32).
+		SetColor("#2dd4bf").
+		SetStrokeWidth("2").
+		SetAttrs(templ.Attributes{"class": "icon", "data-state": "done"}))
+}
+
+func BenchmarkRender_CachedParallel(b *testing.B) {
+	SetRenderCachePolicy(CacheLRU, 100)
+	b.Cleanup(func() {
+		SetRenderCachePolicy(CacheNone, 0)
+		ResetRenderCache()
+	})
+
+	component := CheckCircle.Config().SetSize(32).SetAttrs(templ.Attributes{"class": "icon"})
+	ctx := context.Background()
+	if err := component.Render(ctx, io.Discard); err != nil {
+		b.Fatalf("unexpected error: %v", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if err := component.Render(ctx, io.Discard); err != nil {
+				b.Errorf("unexpected error: %v", err)
+				return
+			}
+		}
+	})
+}
+
+func BenchmarkRender_Parallel(b *testing.B) {
+	component := CheckCircle.Config().SetSize(32)
+	ctx := context.Background()
+	if err := component.Render(ctx, io.Discard); err != nil {
+		b.Fatalf("unexpected error: %v", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if err := component.Render(ctx, io.Discard); err != nil {
+				b.Errorf("unexpected error: %v", err)
+				return
+			}
+		}
+	})
+}
+
+func BenchmarkSVG(b *testing.B) {
+	icon := CheckCircle.Config()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := icon.SVG(); err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+// Benchmarks of the first lookup in a fresh source, comparing the on-demand extraction of
+// a single icon with the extraction of every icon. The heap retained by the source,
+// decompressed dataset included, is reported as retained-B.
+
+func benchmarkFirstLookup(b *testing.B, lookup func(*JSONSource) error) {
+	b.Helper()
+	data, err := iconoirJSON.ReadFile(iconoirJSONFilename)
+	if err != nil {
+		b.Fatalf("unexpected error: %v", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := lookup(NewBytesSource(data)); err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+	}
+	b.StopTimer()
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	source := NewBytesSource(data)
+	if err := lookup(source); err != nil {
+		b.Fatalf("unexpected error: %v", err)
+	}
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	runtime.KeepAlive(source)
+	b.ReportMetric(float64(after.HeapAlloc)-float64(before.HeapAlloc), "retained-B")
+}
+
+func BenchmarkFirstLookup_Lazy(b *testing.B) {
+	benchmarkFirstLookup(b, func(source *JSONSource) error {
+		_, err := source.Icon("check-circle")
+		return err
+	})
+}
+
+func BenchmarkFirstLookup_Eager(b *testing.B) {
+	benchmarkFirstLookup(b, func(source *JSONSource) error {
+		names, err := source.Names()
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			if _, err := source.Icon(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,178 @@
+package templiconoir
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+)
+
+// CachePolicy represents how a JSONSource keeps the icons extracted from its dataset.
+type CachePolicy int
+
+const (
+	// CacheUnbounded keeps every extracted icon, the default.
+	CacheUnbounded CachePolicy = iota
+	// CacheLRU keeps the recently used icons, up to a maximum number of entries.
+	// Recency is approximated (CLOCK, or second chance): the oldest entry not used since it was
+	// last considered is evicted, so entries used in the same period leave in the order they
+	// were added rather than in the order they were used. Hits then never wait for each other.
+	CacheLRU
+	// CacheNone extracts the icon from the dataset on every lookup.
+	CacheNone
+)
+
+// CacheStats reports the activity of the icon cache of a JSONSource, or of the render cache.
+type CacheStats struct {
+	Hits      uint64 // Lookups served from the cache
+	Misses    uint64 // Lookups extracting or rendering the icon
+	Evictions uint64 // Entries dropped to honor the maximum number of entries
+	Entries   int    // Entries currently cached
+}
+
+// lruCache is a concurrency-safe cache following a CachePolicy, holding the icons extracted
+// from a dataset or rendered icons. Its zero value is an unbounded cache.
+type lruCache[K comparable, V any] struct {
+	mu         sync.RWMutex
+	policy     CachePolicy
+	maxEntries int
+	entries    map[K]*list.Element
+	order      *list.List // Entries, most recently added or given a second chance first
+
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+}
+
+// cacheEntry is a value held by an lruCache.
+type cacheEntry[K comparable, V any] struct {
+	key        K
+	value      V
+	referenced atomic.Bool // Used since it was last considered for eviction
+}
+
+// get returns the cached value, counting the lookup as a hit or a miss.
+// Hits only take the read lock: they mark the entry as referenced instead of reordering the entries.
+func (c *lruCache[K, V]) get(key K) (V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	elem, found := c.entries[key]
+	if found && c.policy == CacheLRU {
+		// Checking first avoids writing to the entries shared by concurrent hits
+		if entry := elem.Value.(*cacheEntry[K, V]); !entry.referenced.Load() {
+			entry.referenced.Store(true)
+		}
+	}
+	return c.count(elem, found)
+}
+
+// count records a lookup and returns the value of elem.
+func (c *lruCache[K, V]) count(elem *list.Element, found bool) (V, bool) {
+	if !found {
+		c.misses.Add(1)
+		var zero V
+		return zero, false
+	}
+	c.hits.Add(1)
+	return elem.Value.(*cacheEntry[K, V]).value, true
+}
+
+// disabled reports whether the cache keeps nothing, so that callers can skip building keys.
+func (c *lruCache[K, V]) disabled() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.keepsNothing()
+}
+
+// keepsNothing reports whether the policy caches no value. The lock must be held.
+func (c *lruCache[K, V]) keepsNothing() bool {
+	return c.policy == CacheNone || (c.policy == CacheLRU && c.maxEntries < 1)
+}
+
+// add caches the value according to the policy, evicting the oldest unused values if needed.
+func (c *lruCache[K, V]) add(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.keepsNothing() {
+		return
+	}
+
+	if elem, found := c.entries[key]; found {
+		// Added concurrently by another lookup
+		elem.Value.(*cacheEntry[K, V]).referenced.Store(true)
+		return
+	}
+
+	if c.entries == nil {
+		c.entries = map[K]*list.Element{}
+		c.order = list.New()
+	}
+	added := c.order.PushFront(&cacheEntry[K, V]{key: key, value: value})
+	c.entries[key] = added
+
+	// The oldest entries are evicted, unless they were used since they were last considered.
+	// The added entry is always kept, and a pass clears every mark, so the loop ends.
+	for c.policy == CacheLRU && c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		entry := oldest.Value.(*cacheEntry[K, V])
+		if oldest == added || entry.referenced.Swap(false) {
+			c.order.MoveToFront(oldest)
+			continue
+		}
+		c.order.Remove(oldest)
+		delete(c.entries, entry.key)
+		c.evictions.Add(1)
+	}
+}
+
+// setPolicy changes the policy of the cache, discarding the cached values.
+func (c *lruCache[K, V]) setPolicy(policy CachePolicy, maxEntries int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.policy, c.maxEntries = policy, maxEntries
+	c.entries, c.order = nil, nil
+}
+
+// reset discards the cached values and zeroes the counters.
+func (c *lruCache[K, V]) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries, c.order = nil, nil
+	c.hits.Store(0)
+	c.misses.Store(0)
+	c.evictions.Store(0)
+}
+
+// stats returns the counters and the number of entries of the cache.
+func (c *lruCache[K, V]) stats() CacheStats {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return CacheStats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+		Entries:   len(c.entries),
+	}
+}
+
+// SetCachePolicy sets the cache policy of the embedded dataset of the package-level icons.
+// See JSONSource.SetCachePolicy.
+func SetCachePolicy(policy CachePolicy, maxEntries int) {
+	defaultSource.SetCachePolicy(policy, maxEntries)
+}
+
+// GetCacheStats returns the cache statistics of the embedded dataset of the package-level icons.
+func GetCacheStats() CacheStats {
+	return defaultSource.CacheStats()
+}
+
+// Preload extracts the named icons of the embedded dataset into its cache, or all of them
+// when no name is given. See JSONSource.Preload.
+func Preload(names ...string) error {
+	return defaultSource.Preload(names...)
+}
+
+// ResetCache empties the cache of the embedded dataset and zeroes its statistics.
+func ResetCache() {
+	defaultSource.Reset()
+}
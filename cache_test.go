@@ -0,0 +1,165 @@
+package templiconoir
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestCache_Policies(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        CachePolicy
+		maxEntries    int
+		lookups       []string
+		expectedStats CacheStats
+	}{
+		{
+			name:          "Unbounded cache keeps every icon",
+			policy:        CacheUnbounded,
+			lookups:       []string{"logo", "mark", "brand", "logo", "mark"},
+			expectedStats: CacheStats{Hits: 2, Misses: 3, Entries: 3},
+		},
+		{
+			name:          "LRU cache evicts the least recently used icon",
+			policy:        CacheLRU,
+			maxEntries:    2,
+			lookups:       []string{"logo", "mark", "logo", "brand", "logo", "mark"},
+			expectedStats: CacheStats{Hits: 2, Misses: 4, Evictions: 2, Entries: 2},
+		},
+		{
+			// Exact LRU would evict "mark", used before "logo", and miss it again
+			name:          "LRU cache evicts used icons in the order they were cached",
+			policy:        CacheLRU,
+			maxEntries:    2,
+			lookups:       []string{"logo", "mark", "mark", "logo", "brand", "mark"},
+			expectedStats: CacheStats{Hits: 3, Misses: 3, Evictions: 1, Entries: 2},
+		},
+		{
+			name:          "LRU cache without entries caches nothing",
+			policy:        CacheLRU,
+			lookups:       []string{"logo", "logo"},
+			expectedStats: CacheStats{Misses: 2},
+		},
+		{
+			name:          "No cache extracts on every lookup",
+			policy:        CacheNone,
+			lookups:       []string{"logo", "logo", "mark"},
+			expectedStats: CacheStats{Misses: 3},
+		},
+		{
+			name:          "Unknown icons are misses",
+			policy:        CacheUnbounded,
+			lookups:       []string{"unknown", "unknown"},
+			expectedStats: CacheStats{Misses: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			source := NewBytesSource([]byte(testIconSetJSON))
+			source.SetCachePolicy(tt.policy, tt.maxEntries)
+
+			for _, name := range tt.lookups {
+				icon, err := source.Icon(name)
+				if name == "unknown" {
+					if !errors.Is(err, ErrIconNotFound) {
+						t.Fatalf("Icon(%q) error = %v, want %v", name, err, ErrIconNotFound)
+					}
+					continue
+				}
+				if err != nil || icon.Body == "" {
+					t.Fatalf("Icon(%q) = %+v, %v", name, icon, err)
+				}
+			}
+
+			if stats := source.CacheStats(); stats != tt.expectedStats {
+				t.Errorf("CacheStats() = %+v, want %+v", stats, tt.expectedStats)
+			}
+		})
+	}
+}
+
+func TestCache_PreloadAndReset(t *testing.T) {
+	source := NewBytesSource([]byte(testIconSetJSON))
+
+	if err := source.Preload("brand-wide", "unknown"); !errors.Is(err, ErrIconNotFound) {
+		t.Fatalf("Preload() error = %v, want %v", err, ErrIconNotFound)
+	}
+	if _, err := source.Icon("brand-wide"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stats := source.CacheStats(); stats != (CacheStats{Hits: 1, Entries: 1}) {
+		t.Errorf("CacheStats() after Preload(\"brand-wide\") = %+v, want 1 hit and 1 entry", stats)
+	}
+
+	if err := source.Preload(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stats := source.CacheStats(); stats.Entries != 3 {
+		t.Errorf("CacheStats() after Preload() has %d entries, want the 2 icons and the preloaded alias", stats.Entries)
+	}
+
+	source.Reset()
+	if stats := source.CacheStats(); stats != (CacheStats{}) {
+		t.Errorf("CacheStats() after Reset() = %+v, want zero", stats)
+	}
+	if _, err := source.Icon("logo"); err != nil {
+		t.Fatalf("Icon() after Reset() unexpected error: %v", err)
+	}
+
+	// Changing the policy discards the cached icons
+	source.SetCachePolicy(CacheLRU, 10)
+	if stats := source.CacheStats(); stats.Entries != 0 || stats.Misses != 1 {
+		t.Errorf("CacheStats() after SetCachePolicy() = %+v, want no entries and the previous miss", stats)
+	}
+}
+
+func TestCache_DefaultSource(t *testing.T) {
+	t.Cleanup(func() {
+		SetCachePolicy(CacheUnbounded, 0)
+		ResetCache()
+	})
+
+	SetCachePolicy(CacheLRU, 1)
+	if err := Preload("check-circle", "xmark"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stats := GetCacheStats(); stats.Entries != 1 || stats.Evictions != 1 {
+		t.Errorf("GetCacheStats() = %+v, want 1 entry and 1 eviction", stats)
+	}
+
+	ResetCache()
+	if stats := GetCacheStats(); stats != (CacheStats{}) {
+		t.Errorf("GetCacheStats() after ResetCache() = %+v, want zero", stats)
+	}
+}
+
+func TestCache_ConcurrentLRU(t *testing.T) {
+	source := NewBytesSource([]byte(testIconSetJSON))
+	source.SetCachePolicy(CacheLRU, 2)
+	names := []string{"logo", "mark", "brand", "brand-wide", "brand-turned"}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				name := names[(g+i)%len(names)]
+				if _, err := source.Icon(name); err != nil {
+					t.Errorf("Icon(%q) unexpected error: %v", name, err)
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	stats := source.CacheStats()
+	if stats.Entries != 2 || stats.Hits+stats.Misses != 800 || stats.Evictions > stats.Misses {
+		t.Errorf("CacheStats() = %+v, want 2 entries, 800 lookups and at most an eviction per miss", stats)
+	}
+}
@@ -0,0 +1,62 @@
+package templiconoir
+
+import (
+	"sort"
+	"sync"
+)
+
+var (
+	iconCategoriesByName     map[string][]string // Icon name to its sorted categories
+	iconCategoriesByNameOnce sync.Once
+)
+
+// Categories returns the sorted names of the icon categories (e.g., "Animals", "Git", "Weather").
+func Categories() []string {
+	categories := make([]string, 0, len(iconCategories))
+	for category := range iconCategories {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	return categories
+}
+
+// IconsInCategory returns copies of the icons in category, sorted by name.
+// It returns nil if the category does not exist.
+func IconsInCategory(category string) []*Icon {
+	names, found := iconCategories[category]
+	if !found {
+		return nil
+	}
+
+	icons := make([]*Icon, 0, len(names))
+	for _, name := range names {
+		if icon, found := Lookup(name); found {
+			icons = append(icons, icon)
+		}
+	}
+	return icons
+}
+
+// CategoriesOf returns the sorted categories of the icon registered under name,
+// which can be an icon name or alias.
+func CategoriesOf(name string) []string {
+	iconCategoriesByNameOnce.Do(func() {
+		iconCategoriesByName = make(map[string][]string)
+		for category, names := range iconCategories {
+			for _, iconName := range names {
+				iconCategoriesByName[iconName] = append(iconCategoriesByName[iconName], category)
+			}
+		}
+		for _, categories := range iconCategoriesByName {
+			sort.Strings(categories)
+		}
+	})
+
+	// Aliases are registered with the icon they resolve to
+	if icon, found := iconRegistry[name]; found {
+		name = icon.Name
+	}
+
+	categories := iconCategoriesByName[name]
+	return append([]string(nil), categories...)
+}
@@ -0,0 +1,64 @@
+package templiconoir
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCategories_Categories(t *testing.T) {
+	categories := Categories()
+
+	if !slices.IsSorted(categories) {
+		t.Errorf("Categories() = %v, expected a sorted list", categories)
+	}
+	for _, expected := range []string{"3D Editor", "Animals", "Git", "Weather"} {
+		if !slices.Contains(categories, expected) {
+			t.Errorf("Categories() does not contain %q", expected)
+		}
+	}
+}
+
+func TestCategories_IconsInCategory(t *testing.T) {
+	icons := IconsInCategory("Weather")
+	if len(icons) == 0 {
+		t.Fatalf("IconsInCategory(\"Weather\") returned no icons")
+	}
+
+	names := make([]string, len(icons))
+	for i, icon := range icons {
+		names[i] = icon.Name
+	}
+	if !slices.Contains(names, "snow-flake") {
+		t.Errorf("IconsInCategory(\"Weather\") = %v, expected it to contain snow-flake", names)
+	}
+
+	// The returned icons are copies
+	icons[0].Size = "48"
+	if iconRegistry[icons[0].Name].Size != "24" {
+		t.Errorf("package-level icon modified through IconsInCategory")
+	}
+
+	if icons := IconsInCategory("Non-existing"); icons != nil {
+		t.Errorf("IconsInCategory(\"Non-existing\") = %v, want nil", icons)
+	}
+}
+
+func TestCategories_CategoriesOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		iconName string
+		expected []string
+	}{
+		{name: "Icon name", iconName: "arc-3d", expected: []string{"3D Editor"}},
+		{name: "Alias", iconName: "3d-arc", expected: []string{"3D Editor"}},
+		{name: "Unknown icon", iconName: "non-existing-icon", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := CategoriesOf(tt.iconName); !slices.Equal(result, tt.expected) {
+				t.Errorf("CategoriesOf(%q) = %v, want %v", tt.iconName, result, tt.expected)
+			}
+		})
+	}
+}
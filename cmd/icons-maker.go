@@ -1,6 +1,10 @@
 package main
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"flag"
 	"fmt"
 	"io"
 	"log"
@@ -10,6 +14,7 @@ import (
 	"sort"
 	"strings"
 	"time"
+	"unicode"
 
 	iconoir "github.com/indaco/templiconoir"
 	"github.com/tidwall/gjson"
@@ -19,12 +24,18 @@ import (
 const (
 	Size24 iconoir.Size = "24"
 
-	cacheDuration = 30 * 24 * time.Hour
-	datasetURL    = "https://raw.githubusercontent.com/iconify/icon-sets/refs/heads/master/json/iconoir.json"
-	maxRetries    = 3
-	retryDelay    = 5 * time.Second
-	cacheFile     = "iconoir_cache.json"
-	outputFile    = "iconoir_generated.go"
+	cacheDuration  = 30 * 24 * time.Hour
+	datasetURL     = "https://raw.githubusercontent.com/iconify/icon-sets/refs/heads/master/json/iconoir.json"
+	maxRetries     = 3
+	retryDelay     = 5 * time.Second
+	cacheFile      = "iconoir_cache.json"
+	datasetFile    = "iconoir.json.gz"
+	outputFile     = "iconoir_generated.go"
+	packageName    = "templiconoir"
+	setFile        = "icons.json"
+	setDatasetFile = "icons.json.gz"
+	setOutputFile  = "icons_generated.go"
+	cssOutputFile  = "iconoir.css"
 )
 
 // Utility for consistent error logging
@@ -63,11 +74,47 @@ func saveCache(filepath string, data []byte) error {
 	return os.WriteFile(filepath, data, 0644)
 }
 
+// Saves the minified and gzipped dataset embedded by the generated package, reporting its size.
+func saveCompressedDataset(filepath string, data []byte) error {
+	var minified bytes.Buffer
+	if err := json.Compact(&minified, data); err != nil {
+		return err
+	}
+
+	var compressed bytes.Buffer
+	zw, err := gzip.NewWriterLevel(&compressed, gzip.BestCompression)
+	if err != nil {
+		return err
+	}
+	if _, err := zw.Write(minified.Bytes()); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filepath, compressed.Bytes(), 0644); err != nil {
+		return err
+	}
+	log.Printf("Embedded dataset %s: %s JSON, %s minified, %s gzipped (%.1f%% of the JSON)\n",
+		filepath, formatSize(len(data)), formatSize(minified.Len()), formatSize(compressed.Len()),
+		100*float64(compressed.Len())/float64(len(data)))
+	return nil
+}
+
+// Formats a size in bytes for the build output.
+func formatSize(size int) string {
+	if size < 1024 {
+		return fmt.Sprintf("%d B", size)
+	}
+	return fmt.Sprintf("%.1f KB", float64(size)/1024)
+}
+
 // Fetches the dataset with retry logic.
 func fetchDatasetWithRetry(url string, maxRetries int, delay time.Duration) ([]byte, error) {
 	var lastErr error
 	for attempt := 1; attempt <= maxRetries; attempt++ {
-		log.Printf("Fetching iconoir dataset (attempt %d/%d)...\n", attempt, maxRetries)
+		log.Printf("Fetching icons dataset (attempt %d/%d)...\n", attempt, maxRetries)
 		resp, err := http.Get(url)
 		if err != nil || resp.StatusCode != http.StatusOK {
 			if resp != nil {
@@ -114,25 +161,94 @@ func parseIcons(jsonData []byte) (map[string]*iconoir.Icon, error) {
 	}
 
 	icons := make(map[string]*iconoir.Icon)
+	source := iconoir.NewBytesSource(jsonData)
 
 	result.ForEach(func(key, value gjson.Result) bool {
 		name := key.String()
+		icons[name] = newIconWithDimensions(name, source)
+		return true
+	})
+
+	return icons, nil
+}
+
+// Creates the icon definition for an icon or alias name, with the viewBox read from source.
+func newIconWithDimensions(name string, source iconoir.Source) *iconoir.Icon {
+	icon := newIcon(name)
+	if data, err := source.Icon(name); err == nil {
+		icon.Left, icon.Top, icon.Width, icon.Height = data.Left, data.Top, data.Width, data.Height
+	}
+	return icon
+}
 
-		icon := &iconoir.Icon{
-			Name: name,
-			Size: Size24, // Default size
-			Type: "Outline",
+// Creates the icon definition for an icon or alias name.
+func newIcon(name string) *iconoir.Icon {
+	icon := &iconoir.Icon{
+		Name: name,
+		Size: Size24, // Default size
+		Type: "Outline",
+	}
+
+	if strings.Contains(name, "solid") {
+		icon.Type = "Solid"
+	}
+
+	return icon
+}
+
+// Alias of an icon, as defined in the JSON dataset.
+type iconAlias struct {
+	Parent      string // Name of the icon the alias resolves to
+	Transformed bool   // Whether the alias rotates or flips its parent
+}
+
+// Parses the aliases from the JSON dataset, resolving each alias to its icon.
+func parseAliases(jsonData []byte, icons map[string]*iconoir.Icon) map[string]iconAlias {
+	entries := make(map[string]gjson.Result)
+	gjson.GetBytes(jsonData, "aliases").ForEach(func(key, value gjson.Result) bool {
+		entries[key.String()] = value
+		return true
+	})
+
+	aliases := make(map[string]iconAlias, len(entries))
+	for name := range entries {
+		alias := iconAlias{Parent: name}
+		// Aliases can point to other aliases, follow the chain up to the icon.
+		for hops := 0; hops <= len(entries); hops++ {
+			entry, isAlias := entries[alias.Parent]
+			if !isAlias {
+				break
+			}
+			alias.Parent = entry.Get("parent").String()
+			alias.Transformed = alias.Transformed ||
+				entry.Get("rotate").Int()%4 != 0 || entry.Get("hFlip").Bool() || entry.Get("vFlip").Bool()
 		}
 
-		if strings.Contains(name, "solid") {
-			icon.Type = "Solid"
+		if _, found := icons[alias.Parent]; !found {
+			log.Printf("Skipping alias %q: parent %q not found\n", name, alias.Parent)
+			continue
 		}
+		aliases[name] = alias
+	}
 
-		icons[name] = icon
+	return aliases
+}
+
+// Parses the categories from the JSON dataset, mapping each category to its sorted icon names.
+func parseCategories(jsonData []byte, icons map[string]*iconoir.Icon) map[string][]string {
+	categories := make(map[string][]string)
+	gjson.GetBytes(jsonData, "categories").ForEach(func(key, value gjson.Result) bool {
+		var names []string
+		for _, name := range value.Array() {
+			if _, found := icons[name.String()]; found {
+				names = append(names, name.String())
+			}
+		}
+		sort.Strings(names)
+		categories[key.String()] = names
 		return true
 	})
-
-	return icons, nil
+	return categories
 }
 
 // Cleans and standardizes icon names.
@@ -151,8 +267,36 @@ func generateStructName(icon *iconoir.Icon) string {
 	}
 }
 
-// Generates a Go file with icon definitions.
-func generateGoFile(outputFilePath string, icons map[string]*iconoir.Icon) error {
+// Generates the Go variable names for the aliases, skipping the names that are not valid
+// identifiers or that are already used by an icon.
+func generateAliasNames(icons map[string]*iconoir.Icon, aliases map[string]iconAlias) map[string]string {
+	used := make(map[string]struct{}, len(icons))
+	for _, icon := range icons {
+		used[generateStructName(icon)] = struct{}{}
+	}
+
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	aliasNames := make(map[string]string, len(aliases))
+	for _, name := range names {
+		structName := generateStructName(newIcon(name))
+		if _, taken := used[structName]; taken || structName == "" || !unicode.IsLetter(rune(structName[0])) {
+			continue
+		}
+		used[structName] = struct{}{}
+		aliasNames[name] = structName
+	}
+
+	return aliasNames
+}
+
+// Generates a Go file with icon definitions and the registry used by Lookup.
+// Aliases get their own variables when aliasNames is not empty.
+func generateGoFile(outputFilePath string, source iconoir.Source, info iconoir.DatasetInfo, icons map[string]*iconoir.Icon, aliases map[string]iconAlias, aliasNames map[string]string, categories map[string][]string) error {
 	outFile, err := os.Create(outputFilePath)
 	if err != nil {
 		return err
@@ -161,21 +305,134 @@ func generateGoFile(outputFilePath string, icons map[string]*iconoir.Icon) error
 
 	var builder strings.Builder
 	builder.WriteString("// Code generated by 'scripts/icons-maker.go'; DO NOT EDIT.\n")
-	builder.WriteString("package templiconoir\n\nvar (\n")
+	builder.WriteString("package templiconoir\n\n")
+
+	// The dataset metadata are exposed as constants.
+	builder.WriteString("// Metadata of the icons dataset.\nconst (\n")
+	fmt.Fprintf(&builder, "\tDatasetName = %q\n", info.Name)
+	fmt.Fprintf(&builder, "\tDatasetVersion = %q\n", info.Version)
+	fmt.Fprintf(&builder, "\tDatasetTotal = %d\n", info.Total)
+	fmt.Fprintf(&builder, "\tDatasetAuthor = %q\n", info.Author.Name)
+	fmt.Fprintf(&builder, "\tDatasetAuthorURL = %q\n", info.Author.URL)
+	fmt.Fprintf(&builder, "\tDatasetLicense = %q\n", info.License.SPDX)
+	fmt.Fprintf(&builder, "\tDatasetLicenseURL = %q\n", info.License.URL)
+	var lastModified int64 // Zero when the dataset doesn't define it
+	if !info.LastModified.IsZero() {
+		lastModified = info.LastModified.Unix()
+	}
+	fmt.Fprintf(&builder, "\tDatasetLastModified = %d // Unix time\n", lastModified)
+	builder.WriteString(")\n\nvar (\n")
 	var structs []string
 	for _, icon := range icons {
-		structs = append(structs, fmt.Sprintf("\t%s = &Icon{Name: \"%s\", Type: \"%s\", Size: \"%s\"}\n",
-			generateStructName(icon), icon.Name, icon.Type, icon.Size.String()))
+		structs = append(structs, fmt.Sprintf("\t%s = %s\n", generateStructName(icon), iconLiteral(icon)))
+	}
+	for name, structName := range aliasNames {
+		structs = append(structs, fmt.Sprintf("\t%s = %s\n", structName, iconLiteral(newIconWithDimensions(name, source))))
 	}
 	sort.Strings(structs)
 	for _, structDef := range structs {
 		builder.WriteString(structDef)
 	}
 	builder.WriteString(")\n")
+
+	// The registry maps every icon name and alias to its package-level variable.
+	// Transformed aliases without a variable get their own icon, so the transformations apply.
+	var entries []string
+	for name, icon := range icons {
+		entries = append(entries, fmt.Sprintf("\t\"%s\": %s,\n", name, generateStructName(icon)))
+	}
+	for name, alias := range aliases {
+		value := generateStructName(icons[alias.Parent])
+		if structName, found := aliasNames[name]; found {
+			value = structName
+		} else if alias.Transformed {
+			value = iconLiteral(newIconWithDimensions(name, source))
+		}
+		entries = append(entries, fmt.Sprintf("\t\"%s\": %s,\n", name, value))
+	}
+	sort.Strings(entries)
+	builder.WriteString("\nvar iconRegistry = map[string]*Icon{\n")
+	for _, entry := range entries {
+		builder.WriteString(entry)
+	}
+	builder.WriteString("}\n")
+
+	// The categories map each category to the names of its icons.
+	categoryNames := make([]string, 0, len(categories))
+	for category := range categories {
+		categoryNames = append(categoryNames, category)
+	}
+	sort.Strings(categoryNames)
+	builder.WriteString("\nvar iconCategories = map[string][]string{\n")
+	for _, category := range categoryNames {
+		quoted := make([]string, len(categories[category]))
+		for i, name := range categories[category] {
+			quoted[i] = fmt.Sprintf("%q", name)
+		}
+		fmt.Fprintf(&builder, "\t%q: {%s},\n", category, strings.Join(quoted, ", "))
+	}
+	builder.WriteString("}\n")
+
 	_, err = outFile.WriteString(builder.String())
 	return err
 }
 
+// Generates a Go file for an icon set living in its own package, with one variable per icon
+// of the set embedded from the dataset file next to it.
+func generateSetGoFile(outputFilePath, pkg string, icons map[string]*iconoir.Icon, aliasNames map[string]string) error {
+	outFile, err := os.Create(outputFilePath)
+	if err != nil {
+		return err
+	}
+	defer outFile.Close()
+
+	var builder strings.Builder
+	builder.WriteString("// Code generated by 'scripts/icons-maker.go'; DO NOT EDIT.\n")
+	fmt.Fprintf(&builder, "package %s\n\n", pkg)
+	builder.WriteString("import (\n\t_ \"embed\"\n\n\ticonoir \"github.com/indaco/templiconoir\"\n)\n\n")
+	fmt.Fprintf(&builder, "//go:embed %s\nvar iconsJSON []byte\n\n", setDatasetFile)
+	builder.WriteString("// Set is the icon set of the package, read from the embedded and gzipped Iconify JSON dataset.\n")
+	builder.WriteString("var Set = iconoir.NewIconSet(iconoir.NewBytesSource(iconsJSON))\n\nvar (\n")
+
+	// Names that are not valid identifiers, or that collide once cleaned, are only available through Set.Lookup.
+	names := make([]string, 0, len(icons)+len(aliasNames))
+	for name := range icons {
+		names = append(names, name)
+	}
+	for name := range aliasNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	used := map[string]struct{}{"Set": {}}
+	for _, name := range names {
+		structName := generateStructName(newIcon(name))
+		if _, taken := used[structName]; taken || structName == "" || !unicode.IsLetter(rune(structName[0])) {
+			log.Printf("Skipping variable for %q: %q is not available\n", name, structName)
+			continue
+		}
+		used[structName] = struct{}{}
+		fmt.Fprintf(&builder, "\t%s = Set.Icon(%q)\n", structName, name)
+	}
+	builder.WriteString(")\n")
+
+	_, err = outFile.WriteString(builder.String())
+	return err
+}
+
+// Generates the Go literal of an icon definition.
+// The viewBox is only written when it is known, and its origin only when it is not 0 0.
+func iconLiteral(icon *iconoir.Icon) string {
+	var dimensions string
+	if icon.Left != 0 || icon.Top != 0 {
+		dimensions += fmt.Sprintf(", Left: %g, Top: %g", icon.Left, icon.Top)
+	}
+	if icon.Width != 0 && icon.Height != 0 {
+		dimensions += fmt.Sprintf(", Width: %g, Height: %g", icon.Width, icon.Height)
+	}
+	return fmt.Sprintf("&Icon{Name: \"%s\", Type: \"%s\", Size: \"%s\"%s}", icon.Name, icon.Type, icon.Size.String(), dimensions)
+}
+
 // ensureDir ensures that the specified directory exists. If it does not exist, it creates it.
 func ensureDir(dir string) error {
 	err := os.MkdirAll(dir, 0755)
@@ -185,11 +442,100 @@ func ensureDir(dir string) error {
 	return nil
 }
 
+// Writes the icons stylesheet, for every icon or for the icons named in args.
+func runCSS(args []string) error {
+	flags := flag.NewFlagSet("css", flag.ExitOnError)
+	output := flags.String("o", cssOutputFile, "path of the generated stylesheet")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	outFile, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	defer outFile.Close()
+
+	if err := iconoir.WriteCSS(outFile, flags.Args()...); err != nil {
+		return err
+	}
+
+	log.Printf("%s successfully created.\n", *output)
+	return nil
+}
+
+// Prints the icons matching the query in args, with their Go variable names.
+func runSearch(args []string) error {
+	flags := flag.NewFlagSet("search", flag.ExitOnError)
+	limit := flags.Int("n", 20, "maximum number of results")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: icons-maker search [-n limit] query...")
+		fmt.Fprintln(flags.Output(), "Flags must come before the query.")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	// Flag parsing stops at the first word of the query, so later flags would be searched for.
+	for _, arg := range flags.Args() {
+		if strings.HasPrefix(arg, "-") {
+			return fmt.Errorf("flag %q must come before the query (usage: search [-n limit] query...)", arg)
+		}
+	}
+
+	query := strings.Join(flags.Args(), " ")
+	if strings.TrimSpace(query) == "" {
+		return fmt.Errorf("missing search query")
+	}
+
+	results := iconoir.SearchIcons(query, *limit)
+	if len(results) == 0 {
+		fmt.Printf("No icons found for %q\n", query)
+		return nil
+	}
+	for _, result := range results {
+		fmt.Printf("%-40s iconoir.%s\n", result.Name, generateStructName(newIcon(result.Name)))
+	}
+	return nil
+}
+
 func main() {
-	cacheFilePath := path.Join("..", "data", cacheFile)
-	outputFilePath := path.Join("..", outputFile)
+	// The "css" and "search" subcommands work on the embedded dataset.
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "css":
+			if err := runCSS(os.Args[2:]); err != nil {
+				logAndExit(err, "Generating CSS")
+			}
+			return
+		case "search":
+			if err := runSearch(os.Args[2:]); err != nil {
+				logAndExit(err, "Searching icons")
+			}
+			return
+		}
+	}
+
+	withAliases := flag.Bool("aliases", false, "generate a variable for every alias of the dataset")
+	url := flag.String("url", datasetURL, "URL of the Iconify JSON icon set to download")
+	file := flag.String("file", "", "path of a local Iconify JSON icon set, used instead of downloading one")
+	pkg := flag.String("pkg", packageName, "name of the generated package; other packages get an icon set embedding the dataset")
+	outDir := flag.String("out", "..", "directory of the generated files")
+	flag.Parse()
+
+	// The package itself embeds its dataset from the data directory, other packages next to the generated file.
+	// The downloaded dataset is kept as a cache, and a compressed copy gets embedded.
+	cacheFilePath := path.Join(*outDir, setFile)
+	datasetFilePath := path.Join(*outDir, setDatasetFile)
+	outputFilePath := path.Join(*outDir, setOutputFile)
+	if *pkg == packageName {
+		cacheFilePath = path.Join(*outDir, "data", cacheFile)
+		datasetFilePath = path.Join(*outDir, "data", datasetFile)
+		outputFilePath = path.Join(*outDir, outputFile)
+	}
 
-	// Ensure the "data" directory exists.
+	// Ensure the directory of the dataset exists.
 	dataDir := path.Dir(cacheFilePath)
 	if err := ensureDir(dataDir); err != nil {
 		log.Fatalf("Error ensuring data directory exists: %v", err)
@@ -199,10 +545,26 @@ func main() {
 	var icons map[string]*iconoir.Icon
 	var err error
 
+	if *file != "" {
+		// A local dataset is copied where it gets embedded from.
+		data, err = os.ReadFile(*file)
+		if err != nil {
+			logAndExit(err, "Reading dataset")
+		}
+		if icons, err = parseIcons(data); err != nil {
+			logAndExit(err, "Parsing icons")
+		}
+		if path.Clean(*file) != path.Clean(cacheFilePath) {
+			if err := saveCache(cacheFilePath, data); err != nil {
+				logAndExit(err, "Copying dataset")
+			}
+		}
+	}
+
 	// Attempt to fetch and parse the JSON dataset.
 	forceFetch := false
-	for {
-		data, err = fetchAndCacheDataset(datasetURL, cacheFilePath, cacheDuration)
+	for icons == nil {
+		data, err = fetchAndCacheDataset(*url, cacheFilePath, cacheDuration)
 		if err != nil {
 			logAndExit(err, "Fetching dataset")
 		}
@@ -227,8 +589,34 @@ func main() {
 		}
 	}
 
+	if err := saveCompressedDataset(datasetFilePath, data); err != nil {
+		logAndExit(err, "Compressing dataset")
+	}
+
+	aliases := parseAliases(data, icons)
+	aliasNames := map[string]string{}
+	if *withAliases {
+		aliasNames = generateAliasNames(icons, aliases)
+	}
+
+	// Icon sets of other packages are loaded through an iconoir.IconSet.
+	if *pkg != packageName {
+		if err := generateSetGoFile(outputFilePath, *pkg, icons, aliasNames); err != nil {
+			logAndExit(err, "Generating Go file")
+		}
+		log.Printf("%s successfully created.\n", outputFilePath)
+		return
+	}
+
 	// Generate Go file with icon definitions.
-	if err := generateGoFile(outputFilePath, icons); err != nil {
+	// The metadata constants are read like Info() reads them at runtime.
+	categories := parseCategories(data, icons)
+	source := iconoir.NewBytesSource(data)
+	info, err := source.Info()
+	if err != nil {
+		logAndExit(err, "Parsing metadata")
+	}
+	if err := generateGoFile(outputFilePath, source, info, icons, aliases, aliasNames, categories); err != nil {
 		logAndExit(err, "Generating Go file")
 	}
 
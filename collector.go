@@ -0,0 +1,76 @@
+package templiconoir
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/a-h/templ"
+)
+
+type collectorContextKey struct{}
+
+// Collector records the icons rendered as sprite references during a request,
+// so that their <symbol> definitions can be emitted once with CollectedSpriteSheet.
+// It is safe for concurrent use.
+type Collector struct {
+	mu    sync.Mutex
+	icons []*Icon
+	seen  map[string]struct{}
+}
+
+// NewCollector creates an empty Collector.
+func NewCollector() *Collector {
+	return &Collector{seen: map[string]struct{}{}}
+}
+
+// WithCollector returns a copy of ctx carrying a new Collector.
+// Icons rendered with that context in RenderAuto or RenderSprite mode emit a <use> reference
+// and register themselves in the collector.
+func WithCollector(ctx context.Context) context.Context {
+	return context.WithValue(ctx, collectorContextKey{}, NewCollector())
+}
+
+// CollectorFromContext returns the Collector attached to ctx, or nil if there is none.
+func CollectorFromContext(ctx context.Context) *Collector {
+	collector, _ := ctx.Value(collectorContextKey{}).(*Collector)
+	return collector
+}
+
+// Add registers the icon in the collector. Icons already registered are ignored.
+func (c *Collector) Add(icon *Icon) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := spriteID(icon)
+	if _, found := c.seen[id]; found {
+		return
+	}
+	c.seen[id] = struct{}{}
+	c.icons = append(c.icons, icon.clone())
+}
+
+// Icons returns the registered icons, in registration order.
+func (c *Collector) Icons() []*Icon {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	icons := make([]*Icon, len(c.icons))
+	copy(icons, c.icons)
+	return icons
+}
+
+// CollectedSpriteSheet returns a templ.Component emitting the <symbol> definitions of the icons
+// registered in the Collector attached to the rendering context. Place it at the end of <body>,
+// after every icon of the page. Nothing is rendered when the context has no collector.
+func CollectedSpriteSheet() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		collector := CollectorFromContext(ctx)
+		if collector == nil {
+			return nil
+		}
+
+		_, err := io.WriteString(w, makeSpriteSheet(collector.Icons()))
+		return err
+	})
+}
@@ -0,0 +1,88 @@
+package templiconoir
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+// renderPage renders the icons followed by the collected sprite sheet, as a layout would.
+func renderPage(t *testing.T, ctx context.Context, icons ...templ.Component) string {
+	t.Helper()
+
+	page := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		for _, icon := range icons {
+			if err := icon.Render(ctx, w); err != nil {
+				return err
+			}
+		}
+		return CollectedSpriteSheet().Render(ctx, w)
+	})
+
+	var sb strings.Builder
+	if err := page.Render(ctx, &sb); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return sb.String()
+}
+
+func TestCollector_CollectsRenderedIcons(t *testing.T) {
+	ctx := WithCollector(context.Background())
+
+	result := renderPage(t, ctx,
+		CheckCircle,
+		CheckCircle.Config().SetSize(16),
+		XmarkCircle,
+	)
+
+	if count := strings.Count(result, `<use href="#iconoir-check-circle"/>`); count != 2 {
+		t.Errorf("expected 2 check-circle references, got %d in %q", count, result)
+	}
+	if count := strings.Count(result, `<symbol id="iconoir-check-circle"`); count != 1 {
+		t.Errorf("expected 1 check-circle symbol, got %d in %q", count, result)
+	}
+	if count := strings.Count(result, `<symbol id="iconoir-xmark-circle"`); count != 1 {
+		t.Errorf("expected 1 xmark-circle symbol, got %d in %q", count, result)
+	}
+	if count := strings.Count(result, `<symbol `); count != 2 {
+		t.Errorf("expected exactly 2 symbols, got %d in %q", count, result)
+	}
+
+	names := []string{}
+	for _, icon := range CollectorFromContext(ctx).Icons() {
+		names = append(names, icon.Name)
+	}
+	if strings.Join(names, ",") != "check-circle,xmark-circle" {
+		t.Errorf("Icons() = %v, want [check-circle xmark-circle]", names)
+	}
+}
+
+func TestCollector_InlineIcons(t *testing.T) {
+	ctx := WithCollector(context.Background())
+
+	result := renderPage(t, ctx,
+		CheckCircle.Config().SetRenderMode(RenderInline),
+		XmarkCircle.Config().SetFill("#ef4444"),
+	)
+
+	if strings.Contains(result, `<use href="#iconoir-`) {
+		t.Errorf("expected inline icons only, got %q", result)
+	}
+	if strings.Contains(result, `<symbol `) {
+		t.Errorf("expected no collected symbols, got %q", result)
+	}
+}
+
+func TestCollector_WithoutCollector(t *testing.T) {
+	result := renderPage(t, context.Background(), CheckCircle)
+
+	if strings.Contains(result, `<use `) || strings.Contains(result, `<symbol `) {
+		t.Errorf("expected an inline icon and no sprite sheet, got %q", result)
+	}
+	if !strings.HasSuffix(result, `</svg>`) {
+		t.Errorf("expected the inline icon markup, got %q", result)
+	}
+}
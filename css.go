@@ -0,0 +1,54 @@
+package templiconoir
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// cssClassPrefix is prepended to icon names to build the CSS class names.
+const cssClassPrefix = "iconoir-"
+
+// cssBaseRule is shared by every icon class. The icon is used as a mask over
+// the current text color, so icons follow the CSS `color` of their container.
+const cssBaseRule = `[class^="iconoir-"],
+[class*=" iconoir-"] {
+  display: inline-block;
+  width: 1em;
+  height: 1em;
+  background-color: currentColor;
+  -webkit-mask-image: var(--iconoir-icon);
+  mask-image: var(--iconoir-icon);
+  -webkit-mask-repeat: no-repeat;
+  mask-repeat: no-repeat;
+  -webkit-mask-size: 100% 100%;
+  mask-size: 100% 100%;
+}
+`
+
+// WriteCSS writes a stylesheet with one `.iconoir-<name>` class per icon, rendering the icon
+// with `mask-image` and `background-color: currentColor`. Every icon of the dataset is written
+// when no names are given. The same icons always give the same stylesheet, as data URIs
+// prefix element IDs with the sprite ID of their icon.
+func WriteCSS(w io.Writer, names ...string) error {
+	if len(names) == 0 {
+		var err error
+		if names, err = iconNames(); err != nil {
+			return fmt.Errorf("failed to list icons: %w", err)
+		}
+	}
+
+	out := bufio.NewWriter(w)
+	fmt.Fprint(out, "/* Code generated by templiconoir; DO NOT EDIT. */\n\n")
+	fmt.Fprint(out, cssBaseRule)
+
+	for _, name := range names {
+		uri, err := (&Icon{Name: name, Size: "24"}).DataURI()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "\n.%s%s {\n  --iconoir-icon: url(\"%s\");\n}\n", cssClassPrefix, name, uri)
+	}
+
+	return out.Flush()
+}
@@ -0,0 +1,65 @@
+package templiconoir
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCSS_WriteCSS(t *testing.T) {
+	t.Run("Selected icons", func(t *testing.T) {
+		var sb strings.Builder
+		if err := WriteCSS(&sb, "check-circle", "xmark"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		css := sb.String()
+
+		for _, fragment := range []string{
+			"background-color: currentColor;",
+			"mask-image: var(--iconoir-icon);",
+			".iconoir-check-circle {\n  --iconoir-icon: url(\"data:image/svg+xml,%3Csvg%20",
+			".iconoir-xmark {\n  --iconoir-icon: url(\"data:image/svg+xml,%3Csvg%20",
+		} {
+			if !strings.Contains(css, fragment) {
+				t.Errorf("WriteCSS() output does not contain %q", fragment)
+			}
+		}
+		if count := strings.Count(css, "--iconoir-icon: url("); count != 2 {
+			t.Errorf("expected 2 icon classes, got %d", count)
+		}
+	})
+
+	t.Run("Every icon by default", func(t *testing.T) {
+		names, err := iconNames()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var sb strings.Builder
+		if err := WriteCSS(&sb); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if count := strings.Count(sb.String(), "--iconoir-icon: url("); count != len(names) {
+			t.Errorf("expected %d icon classes, got %d", len(names), count)
+		}
+	})
+
+	t.Run("Same output on every call", func(t *testing.T) {
+		var first, second strings.Builder
+		if err := WriteCSS(&first, "podcast", "emoji-sing-right"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := WriteCSS(&second, "podcast", "emoji-sing-right"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if first.String() != second.String() {
+			t.Errorf("second WriteCSS() output = %q, want %q", second.String(), first.String())
+		}
+	})
+
+	t.Run("Unknown icon", func(t *testing.T) {
+		var sb strings.Builder
+		if err := WriteCSS(&sb, "non-existing-icon"); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
@@ -0,0 +1,81 @@
+package templiconoir
+
+import (
+	"encoding/base64"
+	"strings"
+)
+
+const (
+	dataURIPrefix       = "data:image/svg+xml,"
+	dataURIBase64Prefix = "data:image/svg+xml;base64,"
+)
+
+// DataURI returns the icon as a percent-encoded `data:image/svg+xml` URI,
+// usable in CSS `background-image` and `mask-image` values.
+func (i *Icon) DataURI() (string, error) {
+	svg, err := i.documentSVG()
+	if err != nil {
+		return "", err
+	}
+	return dataURIPrefix + encodeDataURI(svg), nil
+}
+
+// DataURIBase64 returns the icon as a base64 encoded `data:image/svg+xml` URI.
+func (i *Icon) DataURIBase64() (string, error) {
+	svg, err := i.documentSVG()
+	if err != nil {
+		return "", err
+	}
+	return dataURIBase64Prefix + base64.StdEncoding.EncodeToString([]byte(svg)), nil
+}
+
+// documentSVG returns the SVG of the icon as a standalone document. Its IDs cannot collide
+// with other icons, so they are prefixed with the sprite ID instead of generated, keeping
+// the output the same from call to call.
+func (i *Icon) documentSVG() (string, error) {
+	if i.IDPrefix != "" {
+		return i.SVG()
+	}
+	icon := *i
+	icon.IDPrefix = spriteID(i) + "-"
+	return icon.SVG()
+}
+
+// DataURI returns the configured icon as a percent-encoded `data:image/svg+xml` URI.
+func (b *IconBuilder) DataURI() (string, error) {
+	return b.icon.DataURI()
+}
+
+// DataURIBase64 returns the configured icon as a base64 encoded `data:image/svg+xml` URI.
+func (b *IconBuilder) DataURIBase64() (string, error) {
+	return b.icon.DataURIBase64()
+}
+
+// encodeDataURI percent-encodes every byte of value that is not safe in a URI
+// nor in a quoted or unquoted CSS `url()`.
+func encodeDataURI(value string) string {
+	const hex = "0123456789ABCDEF"
+
+	var builder strings.Builder
+	builder.Grow(len(value) * 3 / 2)
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if isDataURISafe(c) {
+			builder.WriteByte(c)
+			continue
+		}
+		builder.WriteByte('%')
+		builder.WriteByte(hex[c>>4])
+		builder.WriteByte(hex[c&0x0F])
+	}
+	return builder.String()
+}
+
+func isDataURISafe(c byte) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		return true
+	default:
+		return strings.IndexByte("-._~!$&*+,;=:@/?", c) >= 0
+	}
+}
@@ -0,0 +1,125 @@
+package templiconoir
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestDataURI_encodeDataURI(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{
+			name:     "Safe characters are kept",
+			value:    "abc-XYZ_0.9~/:=",
+			expected: "abc-XYZ_0.9~/:=",
+		},
+		{
+			name:     "Markup, quotes and spaces are encoded",
+			value:    `<path d="M0 0"/>`,
+			expected: "%3Cpath%20d=%22M0%200%22/%3E",
+		},
+		{
+			name:     "Fragment, percent, parentheses and quotes are encoded",
+			value:    `#a%b(c)'d'`,
+			expected: "%23a%25b%28c%29%27d%27",
+		},
+		{
+			name:     "Non-ASCII bytes are encoded",
+			value:    "é",
+			expected: "%C3%A9",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable for parallel tests.
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel() // Run test in parallel.
+
+			if result := encodeDataURI(tt.value); result != tt.expected {
+				t.Errorf("encodeDataURI() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDataURI_Icon(t *testing.T) {
+	builder := CheckCircle.Config().SetSize(32).SetColor("#2dd4bf")
+	svg := makeSVGTag(builder.GetIcon())
+
+	t.Run("URL-encoded data URI", func(t *testing.T) {
+		uri, err := builder.DataURI()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		payload, found := strings.CutPrefix(uri, "data:image/svg+xml,")
+		if !found {
+			t.Fatalf("DataURI() = %q, expected the svg+xml media type", uri)
+		}
+		decoded, err := url.PathUnescape(payload)
+		if err != nil {
+			t.Fatalf("unexpected error decoding the payload: %v", err)
+		}
+		if decoded != svg {
+			t.Errorf("decoded payload = %q, want %q", decoded, svg)
+		}
+	})
+
+	t.Run("Base64 data URI", func(t *testing.T) {
+		uri, err := builder.DataURIBase64()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		payload, found := strings.CutPrefix(uri, "data:image/svg+xml;base64,")
+		if !found {
+			t.Fatalf("DataURIBase64() = %q, expected the base64 svg+xml media type", uri)
+		}
+		decoded, err := base64.StdEncoding.DecodeString(payload)
+		if err != nil {
+			t.Fatalf("unexpected error decoding the payload: %v", err)
+		}
+		if string(decoded) != svg {
+			t.Errorf("decoded payload = %q, want %q", decoded, svg)
+		}
+	})
+
+	t.Run("Stable element IDs", func(t *testing.T) {
+		for _, encode := range []func() (string, error){Podcast.DataURI, Podcast.DataURIBase64} {
+			first, err := encode()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			second, err := encode()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if first != second {
+				t.Errorf("second data URI = %q, want %q", second, first)
+			}
+		}
+
+		uri, err := Podcast.DataURI()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if decoded, _ := url.PathUnescape(strings.TrimPrefix(uri, "data:image/svg+xml,")); !strings.Contains(decoded, `id="iconoir-podcast-`) {
+			t.Errorf("decoded payload = %q, expected IDs prefixed with the sprite ID", decoded)
+		}
+	})
+
+	t.Run("Unknown icon", func(t *testing.T) {
+		icon := &Icon{Name: "non-existing-icon", Size: "24"}
+		if _, err := icon.DataURI(); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+		if _, err := icon.DataURIBase64(); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
@@ -2,10 +2,20 @@ package templiconoir
 
 import (
 	"embed"
-	"io/fs"
 )
 
-//go:embed data/iconoir_cache.json
+// The dataset is embedded minified and gzipped by the generator, and decompressed on first use.
+//
+//go:embed data/iconoir.json.gz
 var iconoirJSON embed.FS
 
-var iconoirJSONSource fs.FS = iconoirJSON // Default to the embedded FS
+// iconoirJSONFilename is the path of the icons dataset within iconoirJSON.
+const iconoirJSONFilename = "data/iconoir.json.gz"
+
+// defaultSource is the Source of the package-level icons, reading the embedded dataset.
+var defaultSource = NewFSSource(iconoirJSON, iconoirJSONFilename)
+
+// DefaultSource returns the Source of the package-level icons, backed by the embedded Iconoir dataset.
+func DefaultSource() Source {
+	return defaultSource
+}
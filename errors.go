@@ -0,0 +1,14 @@
+package templiconoir
+
+import "errors"
+
+// Errors reported when an icon cannot be rendered. They are wrapped with the details
+// of the failure, use errors.Is to check for them.
+var (
+	// ErrDatasetUnavailable is returned when the icons dataset cannot be read.
+	ErrDatasetUnavailable = errors.New("icons dataset unavailable")
+	// ErrDatasetInvalid is returned when the icons dataset is not valid Iconify JSON.
+	ErrDatasetInvalid = errors.New("invalid icons dataset")
+	// ErrIconNotFound is returned when the icons dataset has no icon or alias with the requested name.
+	ErrIconNotFound = errors.New("icon not found")
+)
@@ -0,0 +1,72 @@
+package templiconoir
+
+import "sync/atomic"
+
+// DefaultFallbackIcon is the placeholder rendered by the FailureFallback policy,
+// unless another one is set with SetFallbackIcon.
+const DefaultFallbackIcon = "question-mark"
+
+// FailurePolicy represents how Render reports an icon that cannot be rendered.
+type FailurePolicy int
+
+const (
+	// FailureDefault applies the policy set with SetFailurePolicy, FailureComment unless changed.
+	FailureDefault FailurePolicy = iota
+	// FailureComment renders an HTML comment describing the error.
+	FailureComment
+	// FailureStrict makes the component return the error from Render, failing the templ render.
+	FailureStrict
+	// FailureFallback renders the fallback icon (see SetFallbackIcon) of the same icon set in place
+	// of the missing one, with the same configuration.
+	FailureFallback
+)
+
+var (
+	failurePolicy atomic.Int32 // Global FailurePolicy
+	fallbackIcon  atomic.Value // Name of the global fallback icon
+)
+
+// SetFailurePolicy sets the policy of the icons configured with FailureDefault.
+// It is safe to call concurrently with rendering.
+func SetFailurePolicy(policy FailurePolicy) {
+	failurePolicy.Store(int32(policy))
+}
+
+// GetFailurePolicy returns the policy of the icons configured with FailureDefault.
+func GetFailurePolicy() FailurePolicy {
+	if policy := FailurePolicy(failurePolicy.Load()); policy != FailureDefault {
+		return policy
+	}
+	return FailureComment
+}
+
+// SetFallbackIcon sets the name of the icon rendered by the FailureFallback policy.
+// An empty name restores DefaultFallbackIcon.
+func SetFallbackIcon(name string) {
+	fallbackIcon.Store(name)
+}
+
+// GetFallbackIcon returns the name of the icon rendered by the FailureFallback policy.
+func GetFallbackIcon() string {
+	name, _ := fallbackIcon.Load().(string)
+	return defaultIfEmpty(name, DefaultFallbackIcon)
+}
+
+// failurePolicy returns the policy applying to the icon.
+func (i *Icon) failurePolicy() FailurePolicy {
+	if i.OnFailure != FailureDefault {
+		return i.OnFailure
+	}
+	return GetFailurePolicy()
+}
+
+// fallback returns the icon rendered in place of i, from the same icon set and with the
+// same configuration. Its own failures are reported as comments, to avoid fallback loops.
+func (i *Icon) fallback() *Icon {
+	fallback := i.clone()
+	fallback.Name = GetFallbackIcon()
+	fallback.Left, fallback.Top, fallback.Width, fallback.Height = 0, 0, 0, 0
+	fallback.body = ""
+	fallback.OnFailure = FailureComment
+	return fallback
+}
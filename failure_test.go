@@ -0,0 +1,133 @@
+package templiconoir
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestFailure_Policies(t *testing.T) {
+	source := mockSource{
+		"existing-icon": `<path d="M0 0"/>`,
+		"question-mark": `<path d="M1 1"/>`,
+		"placeholder":   `<path d="M2 2"/>`,
+	}
+
+	tests := []struct {
+		name          string
+		policy        FailurePolicy
+		iconName      string
+		expected      string
+		expectedError error
+	}{
+		{
+			name:     "Existing icon",
+			policy:   FailureStrict,
+			iconName: "existing-icon",
+			expected: `<path d="M0 0"/>`,
+		},
+		{
+			name:     "Default policy renders a comment",
+			policy:   FailureDefault,
+			iconName: "missing-icon",
+			expected: `<!-- Error: icon not found: 'missing-icon' -->`,
+		},
+		{
+			name:     "Comment policy",
+			policy:   FailureComment,
+			iconName: "missing-icon",
+			expected: `<!-- Error: icon not found: 'missing-icon' -->`,
+		},
+		{
+			name:          "Strict policy returns the error",
+			policy:        FailureStrict,
+			iconName:      "missing-icon",
+			expectedError: ErrIconNotFound,
+		},
+		{
+			name:     "Fallback policy renders the fallback icon",
+			policy:   FailureFallback,
+			iconName: "missing-icon",
+			expected: `width="32" height="32" viewBox="0 0 24 24" fill="none" stroke-width="1.5" aria-hidden="true"><path d="M1 1"/></svg>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			icon := (&Icon{Name: tt.iconName, Size: "24", source: source}).Config().
+				SetSize(32).
+				SetFailurePolicy(tt.policy)
+
+			var builder strings.Builder
+			err := icon.Render(context.Background(), &builder)
+			if !errors.Is(err, tt.expectedError) {
+				t.Fatalf("Render() error = %v, want %v", err, tt.expectedError)
+			}
+			if !strings.Contains(builder.String(), tt.expected) {
+				t.Errorf("Render() wrote %q, want it to contain %q", builder.String(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestFailure_FallbackErrors(t *testing.T) {
+	icon := (&Icon{Name: "missing-icon", Size: "24", source: mockSource{}}).Config().
+		SetFailurePolicy(FailureFallback)
+
+	var builder strings.Builder
+	if err := icon.Render(context.Background(), &builder); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := `<!-- Error: icon not found: 'question-mark' -->`; builder.String() != expected {
+		t.Errorf("Render() wrote %q, want %q", builder.String(), expected)
+	}
+}
+
+func TestFailure_GlobalPolicy(t *testing.T) {
+	defer SetFailurePolicy(FailureDefault)
+	defer SetFallbackIcon("")
+
+	if GetFailurePolicy() != FailureComment {
+		t.Errorf("GetFailurePolicy() = %v, want FailureComment", GetFailurePolicy())
+	}
+	if GetFallbackIcon() != DefaultFallbackIcon {
+		t.Errorf("GetFallbackIcon() = %q, want %q", GetFallbackIcon(), DefaultFallbackIcon)
+	}
+
+	source := mockSource{"placeholder": `<path d="M2 2"/>`}
+	missing := &Icon{Name: "missing-icon", Size: "24", source: source}
+
+	SetFailurePolicy(FailureStrict)
+	if err := missing.Render(context.Background(), &strings.Builder{}); !errors.Is(err, ErrIconNotFound) {
+		t.Errorf("Render() error = %v, want %v", err, ErrIconNotFound)
+	}
+
+	// The policy of the builder takes precedence
+	var builder strings.Builder
+	if err := missing.Config().SetFailurePolicy(FailureComment).Render(context.Background(), &builder); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	SetFailurePolicy(FailureFallback)
+	SetFallbackIcon("placeholder")
+	builder.Reset()
+	if err := missing.Render(context.Background(), &builder); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(builder.String(), `<path d="M2 2"/>`) {
+		t.Errorf("Render() wrote %q, want the placeholder icon", builder.String())
+	}
+}
+
+func TestFailure_FallbackSprite(t *testing.T) {
+	ctx := WithCollector(context.Background())
+	missing := (&Icon{Name: "missing-icon", Size: "24"}).Config().SetFailurePolicy(FailureFallback)
+
+	result := renderPage(t, ctx, missing)
+	for _, expected := range []string{`<use href="#iconoir-question-mark"/>`, `<symbol id="iconoir-question-mark"`} {
+		if !strings.Contains(result, expected) {
+			t.Errorf("expected %q in %q", expected, result)
+		}
+	}
+}
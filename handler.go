@@ -0,0 +1,151 @@
+package templiconoir
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// DefaultCacheControl is the Cache-Control header value used by handlers created with NewHandler.
+const DefaultCacheControl = "public, max-age=86400"
+
+// Handler is an http.Handler serving icons as standalone SVG files at `/{name}.svg`.
+// Use http.StripPrefix to mount it under a sub-path.
+//
+// The following query parameters are supported:
+//   - size: the width and height in pixels (e.g., `?size=32`)
+//   - color: the color of the icon (e.g., `?color=%232dd4bf`)
+//   - stroke-width: the stroke width of the icon (e.g., `?stroke-width=2`)
+type Handler struct {
+	// CacheControl is the value of the Cache-Control header sent with every icon.
+	CacheControl string
+	// Source provides the served icons. The embedded dataset is used when nil.
+	Source Source
+}
+
+// NewHandler creates a Handler using DefaultCacheControl.
+func NewHandler() *Handler {
+	return &Handler{CacheControl: DefaultCacheControl}
+}
+
+// ServeHTTP serves the SVG of the requested icon.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	name, ok := strings.CutSuffix(strings.TrimPrefix(r.URL.Path, "/"), ".svg")
+	if !ok || name == "" || strings.Contains(name, "/") {
+		http.NotFound(w, r)
+		return
+	}
+
+	icon, err := iconFromQuery(name, r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	icon.source = h.Source
+
+	svg, err := icon.SVG()
+	if err != nil {
+		if errors.Is(err, ErrIconNotFound) {
+			http.NotFound(w, r)
+		} else {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	etag := makeETag(svg)
+	header := w.Header()
+	header.Set("Content-Type", "image/svg+xml")
+	header.Set("ETag", etag)
+	if h.CacheControl != "" {
+		header.Set("Cache-Control", h.CacheControl)
+	}
+
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	if r.Method == http.MethodHead {
+		return
+	}
+	_, _ = io.WriteString(w, svg)
+}
+
+// iconFromQuery builds the icon to serve, validating the query parameters.
+func iconFromQuery(name string, query url.Values) (*Icon, error) {
+	// A standalone file needs no unique IDs: a fixed prefix keeps the body, and its ETag, stable
+	icon := &Icon{Name: name, Size: "24", IDPrefix: name + "-"}
+
+	if size := query.Get("size"); size != "" {
+		value, err := strconv.Atoi(size)
+		if err != nil || value <= 0 || value > 4096 {
+			return nil, fmt.Errorf("invalid size '%s'", size)
+		}
+		icon.Size = Size(strconv.Itoa(value))
+	}
+
+	if strokeWidth := query.Get("stroke-width"); strokeWidth != "" {
+		value, err := strconv.ParseFloat(strokeWidth, 64)
+		if err != nil || value <= 0 || math.IsNaN(value) || math.IsInf(value, 0) {
+			return nil, fmt.Errorf("invalid stroke-width '%s'", strokeWidth)
+		}
+		icon.StrokeWidth = strconv.FormatFloat(value, 'f', -1, 64)
+	}
+
+	if color := query.Get("color"); color != "" {
+		if !isValidColor(color) {
+			return nil, fmt.Errorf("invalid color '%s'", color)
+		}
+		icon.Color = color
+	}
+
+	return icon, nil
+}
+
+// isValidColor reports whether value only contains characters found in CSS color values
+// (e.g., `#2dd4bf`, `teal`, `rgb(45, 212, 191)`).
+func isValidColor(value string) bool {
+	if len(value) > 64 {
+		return false
+	}
+	for _, r := range value {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case strings.ContainsRune("#(),.% ", r):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// makeETag returns a strong ETag derived from the served SVG.
+func makeETag(svg string) string {
+	sum := sha256.Sum256([]byte(svg))
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches reports whether the If-None-Match header value matches etag.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
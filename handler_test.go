@@ -0,0 +1,185 @@
+package templiconoir
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	handler := NewHandler()
+
+	tests := []struct {
+		name           string
+		method         string
+		target         string
+		expectedStatus int
+		expectedBody   []string
+	}{
+		{
+			name:           "Serves an icon with default parameters",
+			method:         http.MethodGet,
+			target:         "/check-circle.svg",
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{`<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" aria-hidden="true">`},
+		},
+		{
+			name:           "Applies size, color and stroke width",
+			method:         http.MethodGet,
+			target:         "/page-left.svg?size=48&color=%232dd4bf&stroke-width=2",
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{`width="48" height="48"`, `stroke-width="2" color="#2dd4bf"`, `stroke-linejoin="round" stroke-width="2"`},
+		},
+		{
+			name:           "Unknown icon",
+			method:         http.MethodGet,
+			target:         "/non-existing-icon.svg",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Missing .svg extension",
+			method:         http.MethodGet,
+			target:         "/check-circle",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Nested path",
+			method:         http.MethodGet,
+			target:         "/icons/check-circle.svg",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Invalid size",
+			method:         http.MethodGet,
+			target:         "/check-circle.svg?size=big",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid stroke width",
+			method:         http.MethodGet,
+			target:         "/check-circle.svg?stroke-width=-1",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "NaN stroke width",
+			method:         http.MethodGet,
+			target:         "/check-circle.svg?stroke-width=NaN",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Infinite stroke width",
+			method:         http.MethodGet,
+			target:         "/check-circle.svg?stroke-width=Inf",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid color",
+			method:         http.MethodGet,
+			target:         "/check-circle.svg?color=%22%3E%3Cscript%3E",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Method not allowed",
+			method:         http.MethodPost,
+			target:         "/check-circle.svg",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.target, nil))
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.expectedStatus)
+			}
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			if contentType := rec.Header().Get("Content-Type"); contentType != "image/svg+xml" {
+				t.Errorf("Content-Type = %q, want image/svg+xml", contentType)
+			}
+			if cacheControl := rec.Header().Get("Cache-Control"); cacheControl != DefaultCacheControl {
+				t.Errorf("Cache-Control = %q, want %q", cacheControl, DefaultCacheControl)
+			}
+			for _, fragment := range tt.expectedBody {
+				if !strings.Contains(rec.Body.String(), fragment) {
+					t.Errorf("body = %q, expected it to contain %q", rec.Body.String(), fragment)
+				}
+			}
+		})
+	}
+}
+
+func TestHandler_ETag(t *testing.T) {
+	handler := NewHandler()
+
+	serve := func(target, ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	etag := serve("/check-circle.svg", "").Header().Get("ETag")
+	if !strings.HasPrefix(etag, `"`) || !strings.HasSuffix(etag, `"`) {
+		t.Fatalf("ETag = %q, expected a strong ETag", etag)
+	}
+
+	if other := serve("/check-circle.svg?size=32", "").Header().Get("ETag"); other == etag {
+		t.Errorf("expected different ETags for different parameters, got %q", other)
+	}
+
+	rec := serve("/check-circle.svg", etag)
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotModified)
+	}
+	if rec.Body.Len() != 0 {
+		t.Errorf("expected an empty body, got %q", rec.Body.String())
+	}
+
+	if rec := serve("/check-circle.svg", `"stale"`); rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestHandler_DatasetErrors(t *testing.T) {
+	handler := NewHandler()
+	handler.Source = NewBytesSource([]byte(`{"icons": invalid}`))
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/check-circle.svg", nil))
+	if recorder.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want %d", recorder.Code, http.StatusInternalServerError)
+	}
+}
+
+func TestHandler_StableBody(t *testing.T) {
+	handler := NewHandler()
+
+	serve := func() *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/podcast.svg", nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+		}
+		return rec
+	}
+
+	first, second := serve(), serve()
+	if first.Body.String() != second.Body.String() {
+		t.Errorf("second body = %q, want %q", second.Body.String(), first.Body.String())
+	}
+	if !strings.Contains(first.Body.String(), `id="podcast-`) {
+		t.Errorf("body = %q, expected IDs prefixed with the icon name", first.Body.String())
+	}
+	if etag := second.Header().Get("ETag"); etag != first.Header().Get("ETag") {
+		t.Errorf("second ETag = %q, want %q", etag, first.Header().Get("ETag"))
+	}
+}
@@ -2,8 +2,9 @@ package templiconoir
 
 import (
 	"fmt"
-	"html"
-	"sort"
+	"math"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/a-h/templ"
@@ -20,44 +21,50 @@ var reservedSVGAttributes = map[string]struct{}{
 	"fill":         {},
 }
 
-// sanitizeAttribute ensures that attribute keys and values are safe for inclusion in the SVG tag.
-func sanitizeAttribute(key, value string) (string, string, bool) {
-	// Define allowlist for event attributes
-	allowedEventAttributes := map[string]struct{}{
-		"onclick":  {},
-		"onchange": {},
-		"onhover":  {},
-	}
+// allowedEventAttributes are the event attributes accepted with a simple JS function value.
+var allowedEventAttributes = map[string]struct{}{
+	"onclick":  {},
+	"onchange": {},
+	"onhover":  {},
+}
 
-	// Check for unsafe attributes
+// isSafeAttribute reports whether the attribute can be included in the SVG tag.
+// Event attributes are only accepted without <script> tags or javascript: URLs.
+func isSafeAttribute(key, value string) bool {
 	if _, isEvent := allowedEventAttributes[key]; isEvent {
-		// For event attributes, only allow simple JS functions (no <script> tags, eval, etc.)
-		if strings.Contains(strings.ToLower(value), "<script>") || strings.Contains(strings.ToLower(value), "javascript:") {
-			return "", "", false // Unsafe value
-		}
+		return !containsFold(value, "<script>") && !containsFold(value, "javascript:")
 	}
+	return true
+}
 
-	// Escape any unsafe characters for all attributes
-	escapedKey := html.EscapeString(key)
-	escapedValue := html.EscapeString(value)
-
-	return escapedKey, escapedValue, true // Safe attribute
+// containsFold reports whether substr is within s, ignoring ASCII case.
+func containsFold(s, substr string) bool {
+	for i := 0; i+len(substr) <= len(s); i++ {
+		if strings.EqualFold(s[i:i+len(substr)], substr) {
+			return true
+		}
+	}
+	return false
 }
 
-// addAttributesToSVG adds templ.Attributes to the SVG tag, placing them at the end of the <svg> opening tag.
-// Reserved attributes are skipped to avoid overwriting critical SVG settings.
-// Attributes are sanitized to prevent XSS or injection attacks.
-func addAttributesToSVG(builder *strings.Builder, attrs templ.Attributes) {
+// accessibilityAttributes are the attributes that give an SVG an accessible name or hide it.
+var accessibilityAttributes = []string{"aria-hidden", "aria-label", "aria-labelledby", "aria-describedby", "role"}
+
+// appendAttributes appends templ.Attributes to the SVG tag, placing them at the end of the <svg> opening tag.
+// Reserved attributes and the excluded keys are skipped to avoid overwriting critical SVG settings.
+// Attributes are sanitized and escaped to prevent XSS or injection attacks.
+func appendAttributes(dst []byte, attrs templ.Attributes, exclude ...string) []byte {
 	if len(attrs) == 0 {
-		return
+		return dst
 	}
 
-	// Extract keys and sort them for deterministic order
-	keys := make([]string, 0, len(attrs))
+	// Extract keys and sort them for deterministic order, on the stack for the usual handful of attributes
+	var stack [16]string
+	keys := stack[:0]
 	for key := range attrs {
 		keys = append(keys, key)
 	}
-	sort.Strings(keys)
+	slices.Sort(keys)
 
 	// Process attributes in sorted order
 	for _, key := range keys {
@@ -67,21 +74,171 @@ func addAttributesToSVG(builder *strings.Builder, attrs templ.Attributes) {
 			continue
 		}
 
-		// Skip reserved attributes
-		if _, isReserved := reservedSVGAttributes[key]; isReserved {
+		// Skip reserved, excluded and unsafe attributes
+		if _, isReserved := reservedSVGAttributes[key]; isReserved || slices.Contains(exclude, key) || !isSafeAttribute(key, value) {
 			continue
 		}
 
-		// Sanitize the attribute
-		sanitizedKey, sanitizedValue, ok := sanitizeAttribute(key, value)
-		if !ok {
-			// Skip attributes that are not safe
+		dst = append(dst, ' ')
+		dst = appendEscaped(dst, key)
+		dst = append(dst, `="`...)
+		dst = appendEscaped(dst, value)
+		dst = append(dst, '"')
+	}
+	return dst
+}
+
+// appendEscaped appends s to dst, escaping the same characters as html.EscapeString.
+func appendEscaped(dst []byte, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '<':
+			dst = append(dst, "&lt;"...)
+		case '>':
+			dst = append(dst, "&gt;"...)
+		case '&':
+			dst = append(dst, "&amp;"...)
+		case '\'':
+			dst = append(dst, "&#39;"...)
+		case '"':
+			dst = append(dst, "&#34;"...)
+		default:
+			dst = append(dst, c)
+		}
+	}
+	return dst
+}
+
+// bodyRewrite describes the changes applied to an icon body while it is appended.
+type bodyRewrite struct {
+	prefix            idPrefix // Prefix of the element IDs declared in the body and of the references to them
+	strokeWidth       string   // Replaces every stroke-width value, when set
+	removeStrokeWidth string   // Strips the stroke-width attributes of this value, when set
+	fill              string   // Replaces the fill="currentColor" paints, when set
+	stroke            string   // Replaces the stroke="currentColor" paints, when set
+}
+
+// appendBody appends body to dst with the rewrite applied, in a single pass over its
+// `name="value"` attributes. Only references to IDs declared in body are prefixed.
+func appendBody(dst []byte, body string, rewrite bodyRewrite) []byte {
+	prefixing := !rewrite.prefix.isZero() && strings.Contains(body, ` id="`)
+	if !prefixing && rewrite.strokeWidth == "" && rewrite.removeStrokeWidth == "" && rewrite.fill == "" && rewrite.stroke == "" {
+		return append(dst, body...)
+	}
+
+	rest := body
+	for {
+		eq := strings.Index(rest, `="`)
+		if eq < 0 {
+			break
+		}
+		valueStart := eq + len(`="`)
+		valueEnd := strings.IndexByte(rest[valueStart:], '"')
+		if valueEnd < 0 {
+			break
+		}
+		valueEnd += valueStart
+
+		// Attribute names are preceded by a space
+		var name string
+		nameStart := strings.LastIndexByte(rest[:eq], ' ') + 1
+		if nameStart > 0 {
+			name = rest[nameStart:eq]
+		}
+		value := rest[valueStart:valueEnd]
+
+		if name == "stroke-width" && rewrite.removeStrokeWidth != "" && value == rewrite.removeStrokeWidth {
+			dst = rewrite.appendText(dst, body, rest[:nameStart-1], prefixing)
+			rest = rest[valueEnd+1:]
 			continue
 		}
 
-		// Add the sanitized attribute to the SVG tag
-		fmt.Fprintf(builder, ` %s="%v"`, sanitizedKey, sanitizedValue)
+		dst = rewrite.appendText(dst, body, rest[:valueStart], prefixing)
+		switch {
+		case name == "id" && prefixing:
+			dst = rewrite.prefix.append(dst)
+			dst = append(dst, value...)
+		case name == "stroke-width" && rewrite.strokeWidth != "":
+			dst = appendEscaped(dst, rewrite.strokeWidth)
+		case name == "fill" && rewrite.fill != "" && value == "currentColor":
+			dst = appendEscaped(dst, rewrite.fill)
+		case name == "stroke" && rewrite.stroke != "" && value == "currentColor":
+			dst = appendEscaped(dst, rewrite.stroke)
+		case strings.HasSuffix(name, "href") && prefixing && strings.HasPrefix(value, "#") && hasID(body, value[1:]):
+			dst = append(dst, '#')
+			dst = rewrite.prefix.append(dst)
+			dst = append(dst, value[1:]...)
+		default:
+			dst = rewrite.appendText(dst, body, value, prefixing)
+		}
+		rest = rest[valueEnd:]
+	}
+
+	return rewrite.appendText(dst, body, rest, prefixing)
+}
+
+// appendText appends a part of body, prefixing its `url(#...)` references when prefixing.
+func (r bodyRewrite) appendText(dst []byte, body, text string, prefixing bool) []byte {
+	for prefixing {
+		idx := strings.Index(text, "url(#")
+		if idx < 0 {
+			break
+		}
+		start := idx + len("url(#")
+		end := strings.IndexByte(text[start:], ')')
+		if end < 0 {
+			break
+		}
+		end += start
+
+		dst = append(dst, text[:start]...)
+		if hasID(body, text[start:end]) {
+			dst = r.prefix.append(dst)
+		}
+		dst = append(dst, text[start:end]...)
+		text = text[end:]
+	}
+	return append(dst, text...)
+}
+
+// hasID reports whether body declares an element with the given ID.
+func hasID(body, id string) bool {
+	for {
+		idx := strings.Index(body, ` id="`)
+		if idx < 0 {
+			return false
+		}
+		body = body[idx+len(` id="`):]
+		if len(body) > len(id) && body[len(id)] == '"' && body[:len(id)] == id {
+			return true
+		}
+	}
+}
+
+// hasAnyAttribute reports whether attrs defines at least one of the given keys.
+func hasAnyAttribute(attrs templ.Attributes, keys []string) bool {
+	for _, key := range keys {
+		if _, exists := attrs[key]; exists {
+			return true
+		}
+	}
+	return false
+}
+
+// appendScaledLength appends length, a value such as "24" or "1.5em", with its number
+// multiplied by factor and its unit kept. Values that are not lengths are appended unchanged.
+func appendScaledLength(dst []byte, length string, factor float64) []byte {
+	if factor == 1 {
+		return appendEscaped(dst, length)
+	}
+
+	unit := strings.TrimLeft(length, "+-.0123456789")
+	value, err := strconv.ParseFloat(length[:len(length)-len(unit)], 64)
+	if err != nil {
+		return appendEscaped(dst, length)
 	}
+	dst = appendNumber(dst, math.Round(value*factor*1000)/1000)
+	return appendEscaped(dst, unit)
 }
 
 func defaultIfEmpty(value, defaultValue string) string {
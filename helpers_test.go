@@ -1,16 +1,16 @@
 package templiconoir
 
 import (
-	"strings"
 	"testing"
 
 	"github.com/a-h/templ"
 )
 
-func TestHelpers_addAttributesToSVG(t *testing.T) {
+func TestHelpers_appendAttributes(t *testing.T) {
 	tests := []struct {
 		name     string
 		attrs    templ.Attributes
+		exclude  []string
 		expected string
 	}{
 		{
@@ -70,6 +70,30 @@ func TestHelpers_addAttributesToSVG(t *testing.T) {
 			},
 			expected: ` aria-hidden="true"`, // Unsafe "onclick" is excluded
 		},
+		{
+			name: "Excluded attributes are skipped",
+			attrs: templ.Attributes{
+				"aria-hidden": "true",
+				"class":       "icon",
+				"role":        "presentation",
+			},
+			exclude:  []string{"aria-hidden", "role"},
+			expected: ` class="icon"`,
+		},
+		{
+			name: "Keys and values are escaped",
+			attrs: templ.Attributes{
+				"title": `"quoted" <b>&'`,
+			},
+			expected: ` title="&#34;quoted&#34; &lt;b&gt;&amp;&#39;"`,
+		},
+		{
+			name: "Unsafe event is skipped regardless of case",
+			attrs: templ.Attributes{
+				"onclick": "JavaScript:alert('XSS')",
+			},
+			expected: "",
+		},
 	}
 
 	for _, tt := range tests {
@@ -77,12 +101,153 @@ func TestHelpers_addAttributesToSVG(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel() // Run test in parallel.
 
-			var builder strings.Builder
-			addAttributesToSVG(&builder, tt.attrs)
+			result := string(appendAttributes(nil, tt.attrs, tt.exclude...))
+			if result != tt.expected {
+				t.Errorf("appendAttributes() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHelpers_appendBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		rewrite  bodyRewrite
+		expected string
+	}{
+		{
+			name:     "All stroke widths are replaced",
+			body:     `<g stroke-width="1.5"><path stroke-width="1.22" d="M0 0"/></g>`,
+			rewrite:  bodyRewrite{strokeWidth: "2"},
+			expected: `<g stroke-width="2"><path stroke-width="2" d="M0 0"/></g>`,
+		},
+		{
+			name:     "Stroke widths are escaped",
+			body:     `<path stroke-width="1.5" d="M0 0"/>`,
+			rewrite:  bodyRewrite{strokeWidth: `2" onload="x`},
+			expected: `<path stroke-width="2&#34; onload=&#34;x" d="M0 0"/>`,
+		},
+		{
+			name:     "Only currentColor fills are replaced",
+			body:     `<g fill="none" stroke="currentColor"><path fill="currentColor" d="M0 0"/></g>`,
+			rewrite:  bodyRewrite{fill: "red"},
+			expected: `<g fill="none" stroke="currentColor"><path fill="red" d="M0 0"/></g>`,
+		},
+		{
+			name:     "Fills and strokes are escaped",
+			body:     `<path fill="currentColor" stroke="currentColor"/>`,
+			rewrite:  bodyRewrite{fill: `red"/><script>alert(1)</script><x a="`, stroke: `<b>`},
+			expected: `<path fill="red&#34;/&gt;&lt;script&gt;alert(1)&lt;/script&gt;&lt;x a=&#34;" stroke="&lt;b&gt;"/>`,
+		},
+		{
+			name:     "Attributes sharing a prefix are not touched",
+			body:     `<g stroke="currentColor" stroke-width="1.5"/>`,
+			rewrite:  bodyRewrite{stroke: "blue"},
+			expected: `<g stroke="blue" stroke-width="1.5"/>`,
+		},
+		{
+			name:     "Body without the attribute is returned unchanged",
+			body:     `<path fill="currentColor" d="M0 0"/>`,
+			rewrite:  bodyRewrite{strokeWidth: "2"},
+			expected: `<path fill="currentColor" d="M0 0"/>`,
+		},
+		{
+			name:     "Default stroke widths are removed",
+			body:     `<g stroke="currentColor" stroke-width="1.5"><path stroke-width="1.5" d="M0 0"/></g>`,
+			rewrite:  bodyRewrite{removeStrokeWidth: "1.5"},
+			expected: `<g stroke="currentColor"><path d="M0 0"/></g>`,
+		},
+		{
+			name:     "Other stroke widths are kept",
+			body:     `<g stroke="currentColor" stroke-width="1.5"><path stroke-width="1.22" d="M0 0"/><path stroke-width="1.499" d="M1 1"/></g>`,
+			rewrite:  bodyRewrite{removeStrokeWidth: "1.5"},
+			expected: `<g stroke="currentColor"><path stroke-width="1.22" d="M0 0"/><path stroke-width="1.499" d="M1 1"/></g>`,
+		},
+		{
+			name:     "Body without stroke widths is returned unchanged",
+			body:     `<path fill="currentColor" d="M0 0"/>`,
+			rewrite:  bodyRewrite{removeStrokeWidth: "1.5"},
+			expected: `<path fill="currentColor" d="M0 0"/>`,
+		},
+		{
+			name:     "IDs and href references are prefixed",
+			body:     `<defs><path id="iconoirPodcast0" d="M6 19"/></defs><g><use href="#iconoirPodcast0"/><use href="#iconoirPodcast0"/></g>`,
+			rewrite:  bodyRewrite{prefix: idPrefix{custom: "row1-"}},
+			expected: `<defs><path id="row1-iconoirPodcast0" d="M6 19"/></defs><g><use href="#row1-iconoirPodcast0"/><use href="#row1-iconoirPodcast0"/></g>`,
+		},
+		{
+			name:     "url() references are prefixed",
+			body:     `<mask id="m0"><path d="M0 0"/></mask><path mask="url(#m0)" d="M0 0"/>`,
+			rewrite:  bodyRewrite{prefix: idPrefix{custom: "x-"}},
+			expected: `<mask id="x-m0"><path d="M0 0"/></mask><path mask="url(#x-m0)" d="M0 0"/>`,
+		},
+		{
+			name:     "References to undeclared IDs are kept",
+			body:     `<mask id="m0"/><path mask="url(#other)" fill="url(#m0)"/><use xlink:href="#other"/>`,
+			rewrite:  bodyRewrite{prefix: idPrefix{custom: "x-"}},
+			expected: `<mask id="x-m0"/><path mask="url(#other)" fill="url(#x-m0)"/><use xlink:href="#other"/>`,
+		},
+		{
+			name:     "Generated prefix",
+			body:     `<mask id="m0"/><path mask="url(#m0)"/>`,
+			rewrite:  bodyRewrite{prefix: idPrefix{generated: 36}},
+			expected: `<mask id="iconoir-10-m0"/><path mask="url(#iconoir-10-m0)"/>`,
+		},
+		{
+			name:     "Body without IDs is returned unchanged",
+			body:     `<path d="M0 0"/>`,
+			rewrite:  bodyRewrite{prefix: idPrefix{custom: "x-"}},
+			expected: `<path d="M0 0"/>`,
+		},
+		{
+			name:     "Rewrites are combined",
+			body:     `<mask id="m0"/><g stroke="currentColor" stroke-width="1.5" mask="url(#m0)"><path fill="currentColor"/></g>`,
+			rewrite:  bodyRewrite{prefix: idPrefix{custom: "x-"}, strokeWidth: "2", fill: "red", stroke: "blue"},
+			expected: `<mask id="x-m0"/><g stroke="blue" stroke-width="2" mask="url(#x-m0)"><path fill="red"/></g>`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable for parallel tests.
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel() // Run test in parallel.
+
+			result := string(appendBody(nil, tt.body, tt.rewrite))
+			if result != tt.expected {
+				t.Errorf("appendBody() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHelpers_appendScaledLength(t *testing.T) {
+	tests := []struct {
+		name     string
+		length   string
+		factor   float64
+		expected string
+	}{
+		{name: "Square icon", length: "24", factor: 1, expected: "24"},
+		{name: "Wide icon", length: "24", factor: 2, expected: "48"},
+		{name: "Rounded result", length: "24", factor: 1.0 / 3, expected: "8"},
+		{name: "Decimal result", length: "24", factor: 1.2, expected: "28.8"},
+		{name: "Length with a unit", length: "1.5em", factor: 2, expected: "3em"},
+		{name: "Percentage", length: "50%", factor: 0.5, expected: "25%"},
+		{name: "Not a length", length: "auto", factor: 2, expected: "auto"},
+		{name: "Empty length", length: "", factor: 2, expected: ""},
+		{name: "Escaped length", length: `24" onload="x`, factor: 2, expected: "48&#34; onload=&#34;x"},
+		{name: "Escaped square length", length: `<24>`, factor: 1, expected: "&lt;24&gt;"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable for parallel tests.
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel() // Run test in parallel.
 
-			result := builder.String()
+			result := string(appendScaledLength(nil, tt.length, tt.factor))
 			if result != tt.expected {
-				t.Errorf("addAttributesToSVG() = %q, want %q", result, tt.expected)
+				t.Errorf("appendScaledLength() = %q, want %q", result, tt.expected)
 			}
 		})
 	}
@@ -1,21 +1,21 @@
 package templiconoir
 
 import (
+	"context"
 	_ "embed"
-	"fmt"
 	"io"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/a-h/templ"
-	"github.com/tidwall/gjson"
 )
 
-var (
-	iconBodyCache = map[string]string{}
-	cacheMutex    sync.Mutex
-)
+var idCounter atomic.Uint64 // Source of per-render unique ID prefixes
+
+// defaultStrokeWidth is the stroke width of the Iconoir icons.
+const defaultStrokeWidth = "1.5"
 
 // Size represents the size of UI components (e.g., small, medium, large).
 type Size string
@@ -27,17 +27,96 @@ func (s Size) String() string {
 
 // Icon represents a single icon with its attributes.
 type Icon struct {
-	Name        string `json:"name"`
-	Type        string `json:"type"`
-	Size        Size   `json:"size"`
+	Name        string  `json:"name"`
+	Type        string  `json:"type"`
+	Size        Size    `json:"size"`
+	Left        float64 // Left edge of the viewBox, the dimensions are read from the source when Width is zero
+	Top         float64 // Top edge of the viewBox
+	Width       float64 // Width of the viewBox
+	Height      float64 // Height of the viewBox
 	StrokeWidth string
 	Color       string
+	Fill        string
+	Stroke      string
+	IDPrefix    string
+	Label       string
+	Description string
+	Mode        RenderMode
+	OnFailure   FailurePolicy
 	Attrs       templ.Attributes
-	body        string // Cached Body
+	body        string // Preset body, looked up in the source when empty
+	source      Source // Source of the body, the embedded dataset when nil
+}
+
+// Icons and their builders render themselves in templ templates.
+var (
+	_ templ.Component = (*Icon)(nil)
+	_ templ.Component = (*IconBuilder)(nil)
+)
+
+// Render writes the icon to w according to its render mode, so that an Icon is a templ.Component.
+// Icons that cannot be rendered are reported according to the failure policy of the icon.
+// The markup is built in a pooled buffer and written at once, without allocating,
+// or written from the render cache when it is enabled (see SetRenderCachePolicy).
+func (i *Icon) Render(ctx context.Context, w io.Writer) error {
+	if !i.rendersAsSprite(CollectorFromContext(ctx) != nil) {
+		if svg, cached := i.cachedSVG(); cached {
+			_, err := w.Write(svg)
+			return err
+		}
+	}
+
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	var err error
+	if *buf, err = appendIcon((*buf)[:0], ctx, i); err != nil {
+		return err
+	}
+	_, err = w.Write(*buf)
+	return err
 }
 
-func (i *Icon) Render() templ.Component {
-	return templ.Raw(makeSVGTag(i))
+// SVG returns the inline SVG of the icon, or the error preventing its rendering.
+// Unlike Render, failures are not hidden in an HTML comment: the returned error wraps
+// ErrDatasetUnavailable, ErrDatasetInvalid or ErrIconNotFound.
+func (i *Icon) SVG() (string, error) {
+	if svg, cached := i.cachedSVG(); cached {
+		return string(svg), nil
+	}
+
+	data, err := i.data()
+	if err != nil {
+		return "", err
+	}
+
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	*buf = appendSVG((*buf)[:0], i, data)
+	i.cacheSVG(data, *buf)
+	return string(*buf), nil
+}
+
+// WriteSVG writes the inline SVG of the icon to w, or returns the error preventing its rendering.
+func (i *Icon) WriteSVG(w io.Writer) error {
+	if svg, cached := i.cachedSVG(); cached {
+		_, err := w.Write(svg)
+		return err
+	}
+
+	data, err := i.data()
+	if err != nil {
+		return err
+	}
+
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	*buf = appendSVG((*buf)[:0], i, data)
+	i.cacheSVG(data, *buf)
+	_, err = w.Write(*buf)
+	return err
 }
 
 // IconBuilder is a builder for configuring an Icon.
@@ -67,17 +146,64 @@ func (b *IconBuilder) SetSize(size int) *IconBuilder {
 }
 
 // SetStrokeWidth sets the stroke-width of the icon.
+// The value is applied to the <svg> tag and to every stroked element of the icon body.
 func (b *IconBuilder) SetStrokeWidth(value string) *IconBuilder {
 	b.icon.StrokeWidth = value
 	return b
 }
 
-// SetColor sets the fill color of the icon.
+// SetColor sets the color of the icon.
+// When no color is set, the icon inherits `currentColor` from its container.
 func (b *IconBuilder) SetColor(value string) *IconBuilder {
 	b.icon.Color = value
 	return b
 }
 
+// SetFill overrides the `fill="currentColor"` paints of the icon body.
+func (b *IconBuilder) SetFill(value string) *IconBuilder {
+	b.icon.Fill = value
+	return b
+}
+
+// SetStroke overrides the `stroke="currentColor"` paints of the icon body.
+func (b *IconBuilder) SetStroke(value string) *IconBuilder {
+	b.icon.Stroke = value
+	return b
+}
+
+// SetIDPrefix sets the prefix used to rewrite the element IDs of the icon body.
+// Without a prefix, icons whose body declares IDs get a unique one on every render,
+// so repeated icons on the same page do not share masks or gradients.
+func (b *IconBuilder) SetIDPrefix(prefix string) *IconBuilder {
+	b.icon.IDPrefix = prefix
+	return b
+}
+
+// SetLabel sets the accessible name of the icon, rendered as a <title> element.
+// Icons without a label or description are treated as decorative and hidden from assistive technologies.
+func (b *IconBuilder) SetLabel(label string) *IconBuilder {
+	b.icon.Label = label
+	return b
+}
+
+// SetDescription sets the accessible description of the icon, rendered as a <desc> element.
+func (b *IconBuilder) SetDescription(description string) *IconBuilder {
+	b.icon.Description = description
+	return b
+}
+
+// SetRenderMode sets how the icon is rendered (e.g., inline or as a sprite reference).
+func (b *IconBuilder) SetRenderMode(mode RenderMode) *IconBuilder {
+	b.icon.Mode = mode
+	return b
+}
+
+// SetFailurePolicy sets how the icon is reported when it cannot be rendered.
+func (b *IconBuilder) SetFailurePolicy(policy FailurePolicy) *IconBuilder {
+	b.icon.OnFailure = policy
+	return b
+}
+
 // SetAttrs sets custom attributes for the SVG tag (e.g., `aria-hidden`, `focusable`).
 func (b *IconBuilder) SetAttrs(attrs templ.Attributes) *IconBuilder {
 	b.icon.Attrs = attrs
@@ -89,9 +215,19 @@ func (b *IconBuilder) GetIcon() *Icon {
 	return b.icon
 }
 
-// Render generates the SVG for the configured icon.
-func (b *IconBuilder) Render() templ.Component {
-	return b.icon.Render()
+// Render writes the configured icon to w, so that an IconBuilder is a templ.Component.
+func (b *IconBuilder) Render(ctx context.Context, w io.Writer) error {
+	return b.icon.Render(ctx, w)
+}
+
+// SVG returns the inline SVG of the configured icon, or the error preventing its rendering.
+func (b *IconBuilder) SVG() (string, error) {
+	return b.icon.SVG()
+}
+
+// WriteSVG writes the inline SVG of the configured icon to w, or returns the error preventing its rendering.
+func (b *IconBuilder) WriteSVG(w io.Writer) error {
+	return b.icon.WriteSVG(w)
 }
 
 func (i *Icon) clone() *Icon {
@@ -103,96 +239,238 @@ func (i *Icon) clone() *Icon {
 		Name:        i.Name,
 		Type:        i.Type,
 		Size:        i.Size,
+		Left:        i.Left,
+		Top:         i.Top,
+		Width:       i.Width,
+		Height:      i.Height,
 		StrokeWidth: i.StrokeWidth,
 		Color:       i.Color,
+		Fill:        i.Fill,
+		Stroke:      i.Stroke,
+		IDPrefix:    i.IDPrefix,
+		Label:       i.Label,
+		Description: i.Description,
+		Mode:        i.Mode,
+		OnFailure:   i.OnFailure,
 		Attrs:       attrsCopy,
 		body:        i.body, // The body is shared since it's immutable
+		source:      i.source,
 	}
 }
 
-func (i *Icon) fetchBody() error {
-	if i.body != "" {
-		return nil // Body is already cached
+// getSource returns the Source of the icon body.
+func (i *Icon) getSource() Source {
+	if i.source == nil {
+		return defaultSource
 	}
+	return i.source
+}
+
+// data returns the body and viewBox of the icon. The icon itself is never modified, so that
+// the package-level icons can be rendered concurrently: bodies are cached by the source instead.
+// The viewBox set on the icon takes precedence over the one of the source, and defaults to the
+// Iconoir 24x24 grid.
+func (i *Icon) data() (IconData, error) {
+	data := IconData{Body: i.body}
+	if data.Body == "" {
+		var err error
+		if data, err = i.getSource().Icon(i.Name); err != nil {
+			return IconData{}, err
+		}
+	}
+
+	switch {
+	case i.Width != 0 && i.Height != 0:
+		data.Left, data.Top, data.Width, data.Height = i.Left, i.Top, i.Width, i.Height
+	case data.Width == 0 || data.Height == 0:
+		data.Left, data.Top, data.Width, data.Height = 0, 0, 24, 24
+	}
+	return data, nil
+}
 
-	body, err := getIconBody(i.Name)
+// appendIcon appends the icon rendered inline or as a sprite reference, registering the latter
+// in the Collector attached to ctx, if any. Failures are handled by the failure policy of the icon.
+func appendIcon(dst []byte, ctx context.Context, icon *Icon) ([]byte, error) {
+	data, err := icon.data()
 	if err != nil {
-		return err
+		switch icon.failurePolicy() {
+		case FailureStrict:
+			return dst, err
+		case FailureFallback:
+			return appendIcon(dst, ctx, icon.fallback())
+		default:
+			return append(dst, errorSVGComment(err)...), nil
+		}
+	}
+
+	collector := CollectorFromContext(ctx)
+	if !icon.rendersAsSprite(collector != nil) {
+		start := len(dst)
+		dst = appendSVG(dst, icon, data)
+		icon.cacheSVG(data, dst[start:])
+		return dst, nil
 	}
 
-	i.body = body
-	return nil
+	if collector != nil {
+		collector.Add(icon)
+	}
+	return appendSpriteUse(dst, icon, data), nil
 }
 
 func makeSVGTag(icon *Icon) string {
-	// Set default values for stroke width and color
-	strokeWidth := defaultIfEmpty(icon.StrokeWidth, "1.5")
-	color := defaultIfEmpty(icon.Color, "#000000")
-
-	// Ensure the icon body is fetched and cached
-	if err := icon.fetchBody(); err != nil {
+	data, err := icon.data()
+	if err != nil {
 		return errorSVGComment(err)
 	}
+	return string(appendSVG(nil, icon, data))
+}
 
-	svgTag := fmt.Sprintf(
-		`<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 24 24" fill="none" stroke-width="%s" color="%s"`,
-		icon.Size.String(),
-		icon.Size.String(),
-		strokeWidth,
-		color,
-	)
+// appendSVG appends the inline SVG of the icon with the given body and viewBox.
+func appendSVG(dst []byte, icon *Icon, data IconData) []byte {
+	// Element IDs of the body and of the accessibility elements share the same prefix
+	prefix := icon.idPrefix(icon.isLabelled() || strings.Contains(data.Body, ` id="`))
+	dst = appendSVGOpenTag(dst, icon, data, prefix)
+
+	// Add the icon body and close the </svg> tag.
+	// Most bodies hardcode stroke-width on their inner elements, so a configured
+	// stroke width has to be applied there too to have any visible effect.
+	dst = appendBody(dst, data.Body, bodyRewrite{
+		prefix:      prefix,
+		strokeWidth: icon.StrokeWidth,
+		fill:        icon.Fill,
+		stroke:      icon.Stroke,
+	})
+	return append(dst, `</svg>`...)
+}
 
-	// Add user-defined attributes and close the opening <svg> tag
-	var builder strings.Builder
-	builder.WriteString(svgTag)
-	addAttributesToSVG(&builder, icon.Attrs)
-	builder.WriteString(">")
+// appendSVGOpenTag appends the opening <svg> tag of the icon with the viewBox of box, followed by
+// its accessibility elements. The prefix is used for the IDs of the <title> and <desc> elements.
+func appendSVGOpenTag(dst []byte, icon *Icon, box IconData, prefix idPrefix) []byte {
+	// The size sets the height, and the width follows the aspect ratio of the viewBox
+	// so that non-square icons are not distorted.
+	dst = append(dst, `<svg xmlns="http://www.w3.org/2000/svg" width="`...)
+	dst = appendScaledLength(dst, string(icon.Size), box.Width/box.Height)
+	dst = append(dst, `" height="`...)
+	dst = appendEscaped(dst, string(icon.Size))
+	dst = append(dst, `" viewBox="`...)
+	dst = box.appendViewBox(dst)
+	dst = append(dst, `" fill="none" stroke-width="`...)
+	dst = appendEscaped(dst, defaultIfEmpty(icon.StrokeWidth, defaultStrokeWidth))
+	dst = append(dst, '"')
+
+	// Without an explicit color, the body's currentColor paints inherit the CSS color of the container
+	if icon.Color != "" {
+		dst = append(dst, ` color="`...)
+		dst = appendEscaped(dst, icon.Color)
+		dst = append(dst, '"')
+	}
 
-	// Add the icon body and close the </svg> tag
-	builder.WriteString(icon.body)
-	builder.WriteString(`</svg>`)
+	// Labelled icons are exposed as images, decorative ones are hidden unless the caller says otherwise
+	switch {
+	case icon.isLabelled():
+		dst = append(dst, ` role="img"`...)
+		if icon.Label != "" {
+			dst = append(dst, ` aria-labelledby="`...)
+			dst = prefix.append(dst)
+			dst = append(dst, `title"`...)
+		}
+		if icon.Description != "" {
+			dst = append(dst, ` aria-describedby="`...)
+			dst = prefix.append(dst)
+			dst = append(dst, `desc"`...)
+		}
+		dst = appendAttributes(dst, icon.Attrs, accessibilityAttributes...)
+	case hasAnyAttribute(icon.Attrs, accessibilityAttributes):
+		dst = appendAttributes(dst, icon.Attrs)
+	default:
+		dst = append(dst, ` aria-hidden="true"`...)
+		dst = appendAttributes(dst, icon.Attrs)
+	}
 
-	return builder.String()
+	// Close the opening <svg> tag and add the accessibility elements
+	dst = append(dst, '>')
+	if icon.Label != "" {
+		dst = append(dst, `<title id="`...)
+		dst = prefix.append(dst)
+		dst = append(dst, `title">`...)
+		dst = appendEscaped(dst, icon.Label)
+		dst = append(dst, `</title>`...)
+	}
+	if icon.Description != "" {
+		dst = append(dst, `<desc id="`...)
+		dst = prefix.append(dst)
+		dst = append(dst, `desc">`...)
+		dst = appendEscaped(dst, icon.Description)
+		dst = append(dst, `</desc>`...)
+	}
+	return dst
 }
 
-// getIconBody retrieves the body of an icon by its name, with thread-safe caching.
-var getIconBody = func(name string) (string, error) {
-	cacheMutex.Lock()
-	defer cacheMutex.Unlock()
+func (i *Icon) isLabelled() bool {
+	return i.Label != "" || i.Description != ""
+}
 
-	// Check if the body is already cached.
-	if body, found := iconBodyCache[name]; found {
-		return body, nil
-	}
+// idPrefix is the prefix of the element IDs of a render: the IDPrefix of the icon,
+// or a generated prefix that is unique for the lifetime of the process.
+type idPrefix struct {
+	custom    string // IDPrefix of the icon, escaped when appended
+	generated uint64 // Number of the generated prefix, used when custom is empty
+}
 
-	// Read and parse the JSON data.
-	jsonFilename := "data/iconoir_cache.json"
-	iconoirData, _ := iconoirJSONSource.Open(jsonFilename)
-	defer iconoirData.Close()
+// isZero reports whether the render has no element IDs to prefix.
+func (p idPrefix) isZero() bool {
+	return p.custom == "" && p.generated == 0
+}
 
-	data, _ := io.ReadAll(iconoirData)
+// append appends the prefix to dst.
+func (p idPrefix) append(dst []byte) []byte {
+	switch {
+	case p.custom != "":
+		return appendEscaped(dst, p.custom)
+	case p.generated != 0:
+		dst = append(dst, "iconoir-"...)
+		dst = strconv.AppendUint(dst, p.generated, 36)
+		return append(dst, '-')
+	default:
+		return dst
+	}
+}
 
-	// Check for valid JSON (parsing)
-	if !gjson.ValidBytes(data) {
-		return "", fmt.Errorf("failed to parse iconoir JSON")
+// idPrefix returns the prefix for the element IDs of a render, generating a unique one
+// when the caller did not supply any and the render needs IDs.
+func (i *Icon) idPrefix(needed bool) idPrefix {
+	if i.IDPrefix != "" {
+		return idPrefix{custom: i.IDPrefix}
+	}
+	if needed {
+		return idPrefix{generated: idCounter.Add(1)}
 	}
+	return idPrefix{}
+}
+
+// bufferPool holds the buffers icons are rendered into, to avoid allocating on every render.
+var bufferPool = sync.Pool{
+	New: func() any {
+		buf := make([]byte, 0, 2048)
+		return &buf
+	},
+}
 
-	// Extract the icons key
-	iconsResult := gjson.GetBytes(data, "icons")
+// maxPooledBuffer is the capacity above which buffers are left to the garbage collector,
+// so that a few unusually large renders don't pin memory in the pool.
+const maxPooledBuffer = 64 << 10
 
-	// If the icons key exists, populate the cache
-	if iconsResult.Exists() {
-		iconsResult.ForEach(func(key, value gjson.Result) bool {
-			iconBody := value.Get("body").String()
-			iconBodyCache[key.String()] = iconBody
-			return true
-		})
-	}
+func getBuffer() *[]byte {
+	return bufferPool.Get().(*[]byte)
+}
 
-	// Return the requested icon body
-	body, exists := iconBodyCache[name]
-	if !exists {
-		return "", fmt.Errorf("icon '%s' not found", name)
+func putBuffer(buf *[]byte) {
+	if cap(*buf) <= maxPooledBuffer {
+		bufferPool.Put(buf)
 	}
-	return body, nil
+}
+
+// iconNames returns the sorted names of every icon in the embedded dataset.
+func iconNames() ([]string, error) {
+	return defaultSource.Names()
 }
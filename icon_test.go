@@ -1,11 +1,14 @@
 package templiconoir
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"io/fs"
+	"sort"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/a-h/templ"
@@ -31,7 +34,7 @@ func TestIcon_default(t *testing.T) {
 				icon.body = `<path d="M4.26 10.147a60 60 0 0 0-.491 6.347A48.6 48.6 0 0 1 12 20.904a48.6 48.6 0 0 1 8.232-4.41a61 61 0 0 0-.491-6.347z"/>`
 				return icon
 			},
-			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" color="#000000"><path d="M4.26 10.147a60 60 0 0 0-.491 6.347A48.6 48.6 0 0 1 12 20.904a48.6 48.6 0 0 1 8.232-4.41a61 61 0 0 0-.491-6.347z"/></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" aria-hidden="true"><path d="M4.26 10.147a60 60 0 0 0-.491 6.347A48.6 48.6 0 0 1 12 20.904a48.6 48.6 0 0 1 8.232-4.41a61 61 0 0 0-.491-6.347z"/></svg>`,
 		},
 		{
 			name: "Solid icon with default attributes",
@@ -44,7 +47,7 @@ func TestIcon_default(t *testing.T) {
 				icon.body = `<path d="M12 20a8 8 0 1 0 0-16 8 8 0 0 0 0 16z"/>`
 				return icon
 			},
-			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" color="#000000"><path d="M12 20a8 8 0 1 0 0-16 8 8 0 0 0 0 16z"/></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" aria-hidden="true"><path d="M12 20a8 8 0 1 0 0-16 8 8 0 0 0 0 16z"/></svg>`,
 		},
 		{
 			name: "Mini icon with attributes",
@@ -60,7 +63,7 @@ func TestIcon_default(t *testing.T) {
 				icon.body = `<path d="M10 20a10 10 0 1 0 0-20 10 10 0 0 0 0 20z"/>`
 				return icon
 			},
-			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="20" height="20" viewBox="0 0 24 24" fill="none" stroke-width="1.5" color="#000000" focusable="false"><path d="M10 20a10 10 0 1 0 0-20 10 10 0 0 0 0 20z"/></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="20" height="20" viewBox="0 0 24 24" fill="none" stroke-width="1.5" aria-hidden="true" focusable="false"><path d="M10 20a10 10 0 1 0 0-20 10 10 0 0 0 0 20z"/></svg>`,
 		},
 		{
 			name: "Micro icon with stroke-width and color attributes",
@@ -91,7 +94,7 @@ func TestIcon_default(t *testing.T) {
 				icon.body = `<circle cx="12" cy="12" r="10"/>`
 				return icon
 			},
-			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" color="#000000"><circle cx="12" cy="12" r="10"/></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" aria-hidden="true"><circle cx="12" cy="12" r="10"/></svg>`,
 		},
 		{
 			name: "SetSize modifies size",
@@ -105,7 +108,7 @@ func TestIcon_default(t *testing.T) {
 				// Capture the returned icon after setting size
 				return originalIcon.Config().SetSize(32).GetIcon()
 			},
-			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="32" height="32" viewBox="0 0 24 24" fill="none" stroke-width="1.5" color="#000000"><circle cx="12" cy="12" r="10"/></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="32" height="32" viewBox="0 0 24 24" fill="none" stroke-width="1.5" aria-hidden="true"><circle cx="12" cy="12" r="10"/></svg>`,
 		},
 	}
 
@@ -123,24 +126,10 @@ func TestIcon_default(t *testing.T) {
 }
 
 func TestIcon_makeSVGTag(t *testing.T) {
-	// Save the original implementation
-	originalGetIconBody := getIconBody
-
-	// Defer the restoration of the original function
-	defer func() { getIconBody = originalGetIconBody }()
-
-	// Mock `getIconBody` to return different responses
-	mockGetIconBody := func(name string) (string, error) {
-		switch name {
-		case "existing-icon":
-			return `<path d="M10 20a10 10 0 1 0 0-20 10 10 0 0 0 0 20z"/>`, nil
-		case "error-icon":
-			return "", fmt.Errorf("icon '%s' not found", name)
-		default:
-			return "", fmt.Errorf("icon '%s' not found", name)
-		}
+	// Mock the source to return different responses
+	source := mockSource{
+		"existing-icon": `<path d="M10 20a10 10 0 1 0 0-20 10 10 0 0 0 0 20z"/>`,
 	}
-	getIconBody = mockGetIconBody
 
 	tests := []struct {
 		name           string
@@ -148,32 +137,35 @@ func TestIcon_makeSVGTag(t *testing.T) {
 		expectedOutput string
 	}{
 		{
-			name: "Body already set, should not call getIconBody",
+			name: "Body already set, should not call the source",
 			icon: &Icon{
-				Name: "existing-icon",
-				Size: "24",
-				Type: "Outline",
-				body: `<path d="M12 2a10 10 0 1 0 0 20a10 10 0 0 0 0-20z"/>`,
+				Name:   "existing-icon",
+				Size:   "24",
+				Type:   "Outline",
+				source: source,
+				body:   `<path d="M12 2a10 10 0 1 0 0 20a10 10 0 0 0 0-20z"/>`,
 			},
-			expectedOutput: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" color="#000000"><path d="M12 2a10 10 0 1 0 0 20a10 10 0 0 0 0-20z"/></svg>`,
+			expectedOutput: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" aria-hidden="true"><path d="M12 2a10 10 0 1 0 0 20a10 10 0 0 0 0-20z"/></svg>`,
 		},
 		{
-			name: "Body not set, getIconBody returns successfully",
+			name: "Body not set, source returns successfully",
 			icon: &Icon{
-				Name: "existing-icon",
-				Size: "24",
-				Type: "Outline",
+				Name:   "existing-icon",
+				Size:   "24",
+				Type:   "Outline",
+				source: source,
 			},
-			expectedOutput: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" color="#000000"><path d="M10 20a10 10 0 1 0 0-20 10 10 0 0 0 0 20z"/></svg>`,
+			expectedOutput: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" aria-hidden="true"><path d="M10 20a10 10 0 1 0 0-20 10 10 0 0 0 0 20z"/></svg>`,
 		},
 		{
-			name: "Body not set, getIconBody returns an error",
+			name: "Body not set, source returns an error",
 			icon: &Icon{
-				Name: "error-icon",
-				Size: "24",
-				Type: "Outline",
+				Name:   "error-icon",
+				Size:   "24",
+				Type:   "Outline",
+				source: source,
 			},
-			expectedOutput: `<!-- Error: icon 'error-icon' not found -->`,
+			expectedOutput: `<!-- Error: icon not found: 'error-icon' -->`,
 		},
 	}
 
@@ -187,6 +179,150 @@ func TestIcon_makeSVGTag(t *testing.T) {
 	}
 }
 
+func TestIcon_SVG(t *testing.T) {
+	tests := []struct {
+		name          string
+		icon          *Icon
+		expectedSVG   string
+		expectedError error
+	}{
+		{
+			name:        "Existing icon",
+			icon:        &Icon{Name: "existing-icon", Size: "24", source: mockSource{"existing-icon": `<path d="M0 0"/>`}},
+			expectedSVG: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" aria-hidden="true"><path d="M0 0"/></svg>`,
+		},
+		{
+			name:          "Unknown icon",
+			icon:          &Icon{Name: "error-icon", Size: "24", source: mockSource{}},
+			expectedError: ErrIconNotFound,
+		},
+		{
+			name:          "Invalid dataset",
+			icon:          &Icon{Name: "existing-icon", Size: "24", source: NewBytesSource([]byte(`{"icons": invalid}`))},
+			expectedError: ErrDatasetInvalid,
+		},
+		{
+			name:          "Unavailable dataset",
+			icon:          &Icon{Name: "existing-icon", Size: "24", source: NewFSSource(mockInvalidJSONFS("{}"), "missing.json")},
+			expectedError: ErrDatasetUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svg, err := tt.icon.Config().SVG()
+			if !errors.Is(err, tt.expectedError) {
+				t.Fatalf("SVG() error = %v, want %v", err, tt.expectedError)
+			}
+			if svg != tt.expectedSVG {
+				t.Errorf("SVG() = %q, want %q", svg, tt.expectedSVG)
+			}
+
+			var builder strings.Builder
+			err = tt.icon.Config().WriteSVG(&builder)
+			if !errors.Is(err, tt.expectedError) {
+				t.Fatalf("WriteSVG() error = %v, want %v", err, tt.expectedError)
+			}
+			if builder.String() != tt.expectedSVG {
+				t.Errorf("WriteSVG() wrote %q, want %q", builder.String(), tt.expectedSVG)
+			}
+		})
+	}
+}
+
+func TestIcon_RenderAllocations(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping allocation test in short mode")
+	}
+
+	tests := []struct {
+		name      string
+		component templ.Component
+	}{
+		{name: "Default icon", component: CheckCircle},
+		{name: "Configured icon", component: CheckCircle.Config().SetSize(32).SetColor("#2dd4bf").SetStrokeWidth("2").SetAttrs(templ.Attributes{"class": "icon"})},
+		{name: "Labelled icon", component: CheckCircle.Config().SetLabel("Done")},
+		{name: "Icon with element IDs", component: Podcast},
+		{name: "Wide icon", component: &Icon{Name: "wide", Size: "24", Width: 40, Height: 20, body: `<path d="M0 0"/>`}},
+		{name: "Sprite reference", component: CheckCircle.Config().SetRenderMode(RenderSprite)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			allocs := testing.AllocsPerRun(100, func() {
+				if err := tt.component.Render(ctx, io.Discard); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			})
+			if allocs != 0 {
+				t.Errorf("Render() allocated %v times per run, want 0", allocs)
+			}
+		})
+	}
+}
+
+// TestIcon_ConcurrentRender renders every generated icon from many goroutines, in the ways
+// concurrent handlers would, and is meant to be run with -race.
+func TestIcon_ConcurrentRender(t *testing.T) {
+	const goroutines = 8
+
+	names := make([]string, 0, len(iconRegistry))
+	for name := range iconRegistry {
+		names = append(names, name)
+	}
+	ctx := WithCollector(context.Background())
+
+	var wg sync.WaitGroup
+	errs := make(chan error, goroutines)
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			var sb strings.Builder
+			for _, name := range names {
+				icon := iconRegistry[name]
+				sb.Reset()
+
+				var err error
+				switch g % 4 {
+				case 0:
+					err = icon.Render(context.Background(), &sb)
+				case 1:
+					err = icon.Config().SetSize(32).SetStrokeWidth("2").SetLabel(name).Render(context.Background(), &sb)
+				case 2:
+					err = icon.Render(ctx, &sb)
+				default:
+					var svg string
+					svg, err = icon.SVG()
+					sb.WriteString(svg)
+				}
+				if err != nil {
+					errs <- fmt.Errorf("%s: %w", name, err)
+					return
+				}
+				if out := sb.String(); !strings.HasPrefix(out, "<svg") || !strings.HasSuffix(out, "</svg>") {
+					errs <- fmt.Errorf("%s: unexpected output %q", name, out)
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+
+	// Rendering must leave the shared icons untouched
+	for _, name := range names {
+		if icon := iconRegistry[name]; icon.body != "" {
+			t.Errorf("%s: body cached on the shared icon", name)
+		}
+	}
+}
+
 func TestIcon_SetSize(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -237,6 +373,51 @@ func TestIcon_SetSize(t *testing.T) {
 	}
 }
 
+func TestIcon_Dimensions(t *testing.T) {
+	body := `<path d="M0 0"/>`
+
+	tests := []struct {
+		name     string
+		icon     *Icon
+		expected string
+	}{
+		{
+			name:     "Dimensions default to the Iconoir grid",
+			icon:     &Icon{Name: "square", Size: "24", body: body},
+			expected: `width="24" height="24" viewBox="0 0 24 24"`,
+		},
+		{
+			name:     "Wide icon keeps its aspect ratio",
+			icon:     &Icon{Name: "wide", Size: "24", Width: 40, Height: 20, body: body},
+			expected: `width="48" height="24" viewBox="0 0 40 20"`,
+		},
+		{
+			name:     "Tall icon with an offset viewBox",
+			icon:     &Icon{Name: "tall", Size: "32", Left: -1, Top: 2, Width: 16, Height: 32, body: body},
+			expected: `width="16" height="32" viewBox="-1 2 16 32"`,
+		},
+		{
+			name:     "Size with a unit",
+			icon:     &Icon{Name: "wide", Size: "1.5em", Width: 40, Height: 20, body: body},
+			expected: `width="3em" height="1.5em" viewBox="0 0 40 20"`,
+		},
+		{
+			name:     "Dimensions are read from the source",
+			icon:     &Icon{Name: "wide", Size: "24", source: NewBytesSource([]byte(`{"height": 24, "icons": {"wide": {"body": "<path/>", "width": 36}}}`))},
+			expected: `width="36" height="24" viewBox="0 0 36 24"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := makeSVGTag(tt.icon)
+			if !strings.Contains(result, tt.expected) {
+				t.Errorf("makeSVGTag() = %q, want it to contain %q", result, tt.expected)
+			}
+		})
+	}
+}
+
 func TestIcon_Setters(t *testing.T) {
 	originalIcon := &Icon{
 		Name: "test-icon",
@@ -278,6 +459,214 @@ func TestIcon_Setters(t *testing.T) {
 	}
 }
 
+func TestIcon_SetStrokeWidth(t *testing.T) {
+	tests := []struct {
+		name     string
+		icon     *Icon
+		expected string
+	}{
+		{
+			name: "Outline icon inner stroke widths are rewritten",
+			icon: &Icon{
+				Name: "outline-icon",
+				Size: "24",
+				Type: "Outline",
+				body: `<g fill="none" stroke="currentColor" stroke-width="1.5"><path d="M3 3h18"/><path stroke-width="1.22" d="M3 12h18"/></g>`,
+			},
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="2.5" aria-hidden="true"><g fill="none" stroke="currentColor" stroke-width="2.5"><path d="M3 3h18"/><path stroke-width="2.5" d="M3 12h18"/></g></svg>`,
+		},
+		{
+			name: "Solid icon body is left untouched",
+			icon: &Icon{
+				Name: "solid-icon",
+				Size: "24",
+				Type: "Solid",
+				body: `<path fill="currentColor" fill-rule="evenodd" d="M12 1.25a10.75 10.75 0 1 0 0 21.5a10.75 10.75 0 0 0 0-21.5" clip-rule="evenodd"/>`,
+			},
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="2.5" aria-hidden="true"><path fill="currentColor" fill-rule="evenodd" d="M12 1.25a10.75 10.75 0 1 0 0 21.5a10.75 10.75 0 0 0 0-21.5" clip-rule="evenodd"/></svg>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := makeSVGTag(tt.icon.Config().SetStrokeWidth("2.5").GetIcon())
+			if result != tt.expected {
+				t.Errorf("makeSVGTag() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("Stroke width is escaped", func(t *testing.T) {
+		icon := &Icon{Name: "outline-icon", Size: "24", body: `<path stroke-width="1.5" d="M0 0"/>`}
+		result := makeSVGTag(icon.Config().SetStrokeWidth(`2" onload="x`).GetIcon())
+		expected := `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="2&#34; onload=&#34;x" aria-hidden="true"><path stroke-width="2&#34; onload=&#34;x" d="M0 0"/></svg>`
+		if result != expected {
+			t.Errorf("makeSVGTag() = %q, want %q", result, expected)
+		}
+	})
+
+	t.Run("Real data keeps default stroke widths when unset", func(t *testing.T) {
+		result := makeSVGTag(&Icon{Name: "page-left", Size: "24", Type: "Outline"})
+		if !strings.Contains(result, `<g fill="none" stroke="currentColor" stroke-linecap="round" stroke-linejoin="round" stroke-width="1.5">`) {
+			t.Errorf("makeSVGTag() = %q, expected the dataset stroke-width", result)
+		}
+	})
+
+	t.Run("Real data applies configured stroke width everywhere", func(t *testing.T) {
+		icon := &Icon{Name: "page-left", Size: "24", Type: "Outline"}
+		result := makeSVGTag(icon.Config().SetStrokeWidth("3").GetIcon())
+		if strings.Contains(result, `stroke-width="1.5"`) {
+			t.Errorf("makeSVGTag() = %q, expected every stroke-width to be rewritten", result)
+		}
+		if count := strings.Count(result, `stroke-width="3"`); count != 2 {
+			t.Errorf("expected 2 occurrences of stroke-width=\"3\", got %d", count)
+		}
+	})
+}
+
+func TestIcon_SetColor(t *testing.T) {
+	body := `<g fill="none" stroke="currentColor" stroke-width="1.5"><path d="M3 3h18"/><path fill="currentColor" d="M12 7a.5.5 0 1 1 0-1"/></g>`
+
+	tests := []struct {
+		name     string
+		setup    func(b *IconBuilder) *IconBuilder
+		expected string
+	}{
+		{
+			name:     "No color inherits currentColor from the container",
+			setup:    func(b *IconBuilder) *IconBuilder { return b },
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" aria-hidden="true"><g fill="none" stroke="currentColor" stroke-width="1.5"><path d="M3 3h18"/><path fill="currentColor" d="M12 7a.5.5 0 1 1 0-1"/></g></svg>`,
+		},
+		{
+			name:     "Explicit color is set on the svg tag",
+			setup:    func(b *IconBuilder) *IconBuilder { return b.SetColor("#2dd4bf") },
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" color="#2dd4bf" aria-hidden="true"><g fill="none" stroke="currentColor" stroke-width="1.5"><path d="M3 3h18"/><path fill="currentColor" d="M12 7a.5.5 0 1 1 0-1"/></g></svg>`,
+		},
+		{
+			name:     "Fill override only replaces currentColor fills",
+			setup:    func(b *IconBuilder) *IconBuilder { return b.SetFill("#ef4444") },
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" aria-hidden="true"><g fill="none" stroke="currentColor" stroke-width="1.5"><path d="M3 3h18"/><path fill="#ef4444" d="M12 7a.5.5 0 1 1 0-1"/></g></svg>`,
+		},
+		{
+			name:     "Stroke override only replaces currentColor strokes",
+			setup:    func(b *IconBuilder) *IconBuilder { return b.SetStroke("#3b82f6") },
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" aria-hidden="true"><g fill="none" stroke="#3b82f6" stroke-width="1.5"><path d="M3 3h18"/><path fill="currentColor" d="M12 7a.5.5 0 1 1 0-1"/></g></svg>`,
+		},
+		{
+			name: "Color, fill and stroke are escaped",
+			setup: func(b *IconBuilder) *IconBuilder {
+				return b.SetColor(`#000" onload="x`).SetFill(`red"/><script>alert(1)</script><x a="`).SetStroke(`<b>`)
+			},
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" color="#000&#34; onload=&#34;x" aria-hidden="true"><g fill="none" stroke="&lt;b&gt;" stroke-width="1.5"><path d="M3 3h18"/><path fill="red&#34;/&gt;&lt;script&gt;alert(1)&lt;/script&gt;&lt;x a=&#34;" d="M12 7a.5.5 0 1 1 0-1"/></g></svg>`,
+		},
+		{
+			name: "Color, fill and stroke combined",
+			setup: func(b *IconBuilder) *IconBuilder {
+				return b.SetColor("#000000").SetFill("#ef4444").SetStroke("#3b82f6")
+			},
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" color="#000000" aria-hidden="true"><g fill="none" stroke="#3b82f6" stroke-width="1.5"><path d="M3 3h18"/><path fill="#ef4444" d="M12 7a.5.5 0 1 1 0-1"/></g></svg>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			icon := &Icon{Name: "mixed-icon", Size: "24", Type: "Outline", body: body}
+			result := makeSVGTag(tt.setup(icon.Config()).GetIcon())
+			if result != tt.expected {
+				t.Errorf("makeSVGTag() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIcon_UniqueIDs(t *testing.T) {
+	t.Run("Repeated renders get distinct IDs", func(t *testing.T) {
+		first := makeSVGTag(Podcast)
+		second := makeSVGTag(Podcast)
+
+		if strings.Contains(first, `id="iconoirPodcast0"`) {
+			t.Errorf("makeSVGTag() = %q, expected the body ID to be prefixed", first)
+		}
+		if first == second {
+			t.Errorf("expected distinct output for repeated renders, got %q twice", first)
+		}
+	})
+
+	t.Run("Caller-supplied prefix is used for IDs and references", func(t *testing.T) {
+		result := makeSVGTag(Podcast.Config().SetIDPrefix("row-7-").GetIcon())
+
+		if !strings.Contains(result, `id="row-7-iconoirPodcast0"`) {
+			t.Errorf("makeSVGTag() = %q, expected the prefixed ID", result)
+		}
+		if count := strings.Count(result, `href="#row-7-iconoirPodcast0"`); count != 2 {
+			t.Errorf("expected 2 prefixed references, got %d", count)
+		}
+	})
+
+	t.Run("Bodies without IDs are rendered unchanged", func(t *testing.T) {
+		icon := &Icon{Name: "plain", Size: "24", Type: "Outline", body: `<path d="M0 0"/>`}
+		if makeSVGTag(icon) != makeSVGTag(icon) {
+			t.Errorf("expected identical output for bodies without IDs")
+		}
+	})
+}
+
+func TestIcon_Accessibility(t *testing.T) {
+	body := `<path d="M0 0"/>`
+
+	tests := []struct {
+		name     string
+		setup    func(b *IconBuilder) *IconBuilder
+		expected string
+	}{
+		{
+			name:     "Decorative icons are hidden by default",
+			setup:    func(b *IconBuilder) *IconBuilder { return b },
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" aria-hidden="true"><path d="M0 0"/></svg>`,
+		},
+		{
+			name: "Caller-provided aria-label replaces the default",
+			setup: func(b *IconBuilder) *IconBuilder {
+				return b.SetAttrs(templ.Attributes{"aria-label": "Close"})
+			},
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" aria-label="Close"><path d="M0 0"/></svg>`,
+		},
+		{
+			name:     "Label renders a title wired with aria-labelledby",
+			setup:    func(b *IconBuilder) *IconBuilder { return b.SetIDPrefix("a-").SetLabel("Close") },
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" role="img" aria-labelledby="a-title"><title id="a-title">Close</title><path d="M0 0"/></svg>`,
+		},
+		{
+			name: "Label and description are escaped and wired",
+			setup: func(b *IconBuilder) *IconBuilder {
+				return b.SetIDPrefix("b-").
+					SetLabel("Tom & Jerry").
+					SetDescription("<Episode list>").
+					SetAttrs(templ.Attributes{"aria-hidden": "true", "class": "icon"})
+			},
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" role="img" aria-labelledby="b-title" aria-describedby="b-desc" class="icon"><title id="b-title">Tom &amp; Jerry</title><desc id="b-desc">&lt;Episode list&gt;</desc><path d="M0 0"/></svg>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			icon := &Icon{Name: "a11y-icon", Size: "24", Type: "Outline", body: body}
+			result := makeSVGTag(tt.setup(icon.Config()).GetIcon())
+			if result != tt.expected {
+				t.Errorf("makeSVGTag() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("Generated IDs are unique per render", func(t *testing.T) {
+		icon := &Icon{Name: "a11y-icon", Size: "24", Type: "Outline", body: body}
+		labelled := icon.Config().SetLabel("Close").GetIcon()
+		if first, second := makeSVGTag(labelled), makeSVGTag(labelled); first == second {
+			t.Errorf("expected distinct title IDs, got %q twice", first)
+		}
+	})
+}
+
 func TestIcon_SetAttrs(t *testing.T) {
 	t.Parallel() // Run test in parallel.
 
@@ -307,7 +696,7 @@ func TestIcon_SetAttrs(t *testing.T) {
 		}
 	}
 
-	expectedSVG := `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" color="#000000" aria-hidden="true" custom-attr="custom-val" focusable="false"><path d="M10 20a10 10 0 1 0 0-20 10 10 0 0 0 0 20z"/></svg>`
+	expectedSVG := `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" aria-hidden="true" custom-attr="custom-val" focusable="false"><path d="M10 20a10 10 0 1 0 0-20 10 10 0 0 0 0 20z"/></svg>`
 	if svg := makeSVGTag(finalIcon); svg != expectedSVG {
 		t.Errorf("String() = %s, want %s", svg, expectedSVG)
 	}
@@ -321,10 +710,12 @@ func TestIcon_SetAttrs(t *testing.T) {
 func TestIcon_Clone(t *testing.T) {
 	// Original icon setup
 	originalIcon := &Icon{
-		Name:  "test-icon",
-		Type:  "Outline",
-		Size:  "24",
-		Color: "#FF0000",
+		Name:   "test-icon",
+		Type:   "Outline",
+		Size:   "24",
+		Color:  "#FF0000",
+		Fill:   "#00FF00",
+		Stroke: "#0000FF",
 		Attrs: templ.Attributes{
 			"aria-hidden": "true",
 			"focusable":   "false",
@@ -348,6 +739,12 @@ func TestIcon_Clone(t *testing.T) {
 	if clonedIcon.Color != originalIcon.Color {
 		t.Errorf("Clone failed: expected Color %q, got %q", originalIcon.Color, clonedIcon.Color)
 	}
+	if clonedIcon.Fill != originalIcon.Fill {
+		t.Errorf("Clone failed: expected Fill %q, got %q", originalIcon.Fill, clonedIcon.Fill)
+	}
+	if clonedIcon.Stroke != originalIcon.Stroke {
+		t.Errorf("Clone failed: expected Stroke %q, got %q", originalIcon.Stroke, clonedIcon.Stroke)
+	}
 	if clonedIcon.body != originalIcon.body {
 		t.Errorf("Clone failed: expected body %q, got %q", originalIcon.body, clonedIcon.body)
 	}
@@ -394,7 +791,7 @@ func TestIcon_SetSizeIsolation(t *testing.T) {
 // 2. Tests for JSON-Based Functionality
 // These tests cover JSON parsing, caching, and error handling.
 
-func TestGetIconBody_RealData(t *testing.T) {
+func TestDefaultSource_Body(t *testing.T) {
 	tests := []struct {
 		name           string
 		iconName       string
@@ -429,7 +826,7 @@ func TestGetIconBody_RealData(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			body, err := getIconBody(tt.iconName)
+			body, err := defaultSource.Body(tt.iconName)
 
 			if tt.expectingError {
 				if err == nil {
@@ -440,22 +837,22 @@ func TestGetIconBody_RealData(t *testing.T) {
 					t.Errorf("unexpected error: %v", err)
 				}
 				if body != tt.expectedBody {
-					t.Errorf("getIconBody() = %q, want %q", body, tt.expectedBody)
+					t.Errorf("Body() = %q, want %q", body, tt.expectedBody)
 				}
 			}
 		})
 	}
 }
 
-func TestGetIconBody_OnceWithRealData(t *testing.T) {
+func TestDefaultSource_OnceWithRealData(t *testing.T) {
 	// First call should initialize the data
-	_, err := getIconBody("check-circle")
+	_, err := defaultSource.Body("check-circle")
 	if err != nil {
 		t.Fatalf("unexpected error during first call: %v", err)
 	}
 
 	// Ensure no error on subsequent calls for valid icons
-	_, err = getIconBody("chromecast")
+	_, err = defaultSource.Body("chromecast")
 	if err != nil {
 		t.Fatalf("unexpected error during subsequent call: %v", err)
 	}
@@ -465,39 +862,37 @@ func TestGetIconBody_OnceWithRealData(t *testing.T) {
 // These tests cover cases where mocked FS and invalid JSON are used.
 
 func TestIcon_String_FetchBody(t *testing.T) {
-	resetTestState()
-
 	// Mock the embedded JSON with valid data
 	validJSON := `{
+        "width": 24,
+        "height": 24,
         "icons": {
             "voice-xmark": { "body": "<path fill=\"none\" stroke=\"currentColor\" stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"1.5\" d=\"M12 3v16M8 8v6m12-5v4M4 9v4m12-7v8m.121 7.364l2.122-2.121m0 0l2.121-2.122m-2.121 2.122L16.12 17.12m2.122 2.122l2.121 2.121\"/>7" },
 			"meter-arrow-down-right": { "body": "<g fill=\"none\" stroke=\"currentColor\" stroke-width=\"1.5\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M2.5 3.5L7 8m0 0V4m0 4H3m12 8l-3.5-3.5\"/><path d=\"M14.5 9C10.358 9 7 12.283 7 16.333a7.2 7.2 0 0 0 .733 3.165a.93.93 0 0 0 .84.502h11.853a.93.93 0 0 0 .841-.502A7.2 7.2 0 0 0 22 16.333C22 12.283 18.642 9 14.5 9Z\"/></g>" }
         }
     }`
-	iconoirJSONSource = mockInvalidJSONFS(validJSON)
-	defer func() {
-		iconoirJSONSource = iconoirJSON // Restore original embedded JSON
-	}()
+	source := NewBytesSource([]byte(validJSON))
 
 	t.Run("Fetches and caches body", func(t *testing.T) {
 		icon := &Icon{
-			Name: "meter-arrow-down-right",
-			Size: "24",
-			Type: "Outline",
+			Name:   "meter-arrow-down-right",
+			Size:   "24",
+			Type:   "Outline",
+			source: source,
 		}
 
 		// Call String() for the first time to trigger the body fetch
 		result := makeSVGTag(icon) // Pass a pointer
 
 		// Validate the resulting SVG
-		expected := `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" color="#000000"><g fill="none" stroke="currentColor" stroke-width="1.5"><path stroke-linecap="round" stroke-linejoin="round" d="M2.5 3.5L7 8m0 0V4m0 4H3m12 8l-3.5-3.5"/><path d="M14.5 9C10.358 9 7 12.283 7 16.333a7.2 7.2 0 0 0 .733 3.165a.93.93 0 0 0 .84.502h11.853a.93.93 0 0 0 .841-.502A7.2 7.2 0 0 0 22 16.333C22 12.283 18.642 9 14.5 9Z"/></g></svg>`
+		expected := `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" aria-hidden="true"><g fill="none" stroke="currentColor" stroke-width="1.5"><path stroke-linecap="round" stroke-linejoin="round" d="M2.5 3.5L7 8m0 0V4m0 4H3m12 8l-3.5-3.5"/><path d="M14.5 9C10.358 9 7 12.283 7 16.333a7.2 7.2 0 0 0 .733 3.165a.93.93 0 0 0 .84.502h11.853a.93.93 0 0 0 .841-.502A7.2 7.2 0 0 0 22 16.333C22 12.283 18.642 9 14.5 9Z"/></g></svg>`
 		if result != expected {
 			t.Errorf("String() = %q, want %q", result, expected)
 		}
 	})
 }
 
-func TestGetIconBody_JSONParsing(t *testing.T) {
+func TestBytesSource_JSONParsing(t *testing.T) {
 	tests := []struct {
 		name           string
 		mockJSON       string
@@ -509,13 +904,13 @@ func TestGetIconBody_JSONParsing(t *testing.T) {
 			name:          "Invalid JSON format",
 			mockJSON:      `{"icons": "invalid"`, // Invalid JSON structure
 			iconName:      "academic-cap",
-			expectedError: "failed to parse iconoir JSON",
+			expectedError: "invalid icons dataset: malformed JSON",
 		},
 		{
 			name:          "Missing icons field",
 			mockJSON:      `{"missingIcons": {}}`, // No `icons` key
 			iconName:      "academic",
-			expectedError: "icon 'academic' not found",
+			expectedError: "icon not found: 'academic'",
 		},
 		{
 			name:           "Valid JSON",
@@ -528,21 +923,16 @@ func TestGetIconBody_JSONParsing(t *testing.T) {
 			name:          "Icon not found",
 			mockJSON:      `{"icons": {"academic-cap": {"body": "<path d='...'/>"}}}`,
 			iconName:      "non-existent-icon",
-			expectedError: "icon 'non-existent-icon' not found",
+			expectedError: "icon not found: 'non-existent-icon'",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			resetTestState()
+			// Read the dataset from a mocked FS
+			source := NewFSSource(mockInvalidJSONFS(tt.mockJSON), "data/iconoir_cache.json")
 
-			// Replace iconoirJSONSource with a mocked FS
-			iconoirJSONSource = mockInvalidJSONFS(tt.mockJSON)
-			defer func() {
-				iconoirJSONSource = iconoirJSON // Restore original embedded FS
-			}()
-
-			result, err := getIconBody(tt.iconName)
+			result, err := source.Body(tt.iconName)
 
 			if tt.expectedError != "" {
 				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
@@ -558,7 +948,7 @@ func TestGetIconBody_JSONParsing(t *testing.T) {
 }
 
 // 4. Utility Functions for Testing
-// These utilities mock data and manage state resets.
+// These utilities mock data sources.
 
 type mockFS struct {
 	data map[string]string
@@ -594,8 +984,28 @@ func (f *mockFile) Stat() (fs.FileInfo, error) {
 	return nil, errors.New("not implemented")
 }
 
-func resetTestState() {
-	iconBodyCache = map[string]string{}
+// mockSource is a Source serving the bodies of a map.
+type mockSource map[string]string
+
+func (m mockSource) Icon(name string) (IconData, error) {
+	body, exists := m[name]
+	if !exists {
+		return IconData{}, fmt.Errorf("%w: '%s'", ErrIconNotFound, name)
+	}
+	return IconData{Body: body, Width: 24, Height: 24}, nil
+}
+
+func (m mockSource) Names() ([]string, error) {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (m mockSource) Info() (DatasetInfo, error) {
+	return DatasetInfo{Prefix: "mock", Total: len(m)}, nil
 }
 
 func TestMockFS(t *testing.T) {
@@ -1,1644 +1,3685 @@
 // Code generated by 'scripts/icons-maker.go'; DO NOT EDIT.
 package templiconoir
 
+// Metadata of the icons dataset.
+const (
+	DatasetName = "Iconoir"
+	DatasetVersion = "7.10.1"
+	DatasetTotal = 1628
+	DatasetAuthor = "Luca Burgio"
+	DatasetAuthorURL = "https://github.com/iconoir-icons/iconoir"
+	DatasetLicense = "MIT"
+	DatasetLicenseURL = "https://github.com/iconoir-icons/iconoir/blob/main/LICENSE"
+	DatasetLastModified = 1732952072 // Unix time
+)
+
 var (
-	Accessibility = &Icon{Name: "accessibility", Type: "Outline", Size: "24"}
-	AccessibilitySign = &Icon{Name: "accessibility-sign", Type: "Outline", Size: "24"}
-	AccessibilityTech = &Icon{Name: "accessibility-tech", Type: "Outline", Size: "24"}
-	Activity = &Icon{Name: "activity", Type: "Outline", Size: "24"}
-	AdobeAfterEffects = &Icon{Name: "adobe-after-effects", Type: "Outline", Size: "24"}
-	AdobeAfterEffectsSolid = &Icon{Name: "adobe-after-effects-solid", Type: "Solid", Size: "24"}
-	AdobeIllustrator = &Icon{Name: "adobe-illustrator", Type: "Outline", Size: "24"}
-	AdobeIllustratorSolid = &Icon{Name: "adobe-illustrator-solid", Type: "Solid", Size: "24"}
-	AdobeIndesign = &Icon{Name: "adobe-indesign", Type: "Outline", Size: "24"}
-	AdobeIndesignSolid = &Icon{Name: "adobe-indesign-solid", Type: "Solid", Size: "24"}
-	AdobeLightroom = &Icon{Name: "adobe-lightroom", Type: "Outline", Size: "24"}
-	AdobeLightroomSolid = &Icon{Name: "adobe-lightroom-solid", Type: "Solid", Size: "24"}
-	AdobePhotoshop = &Icon{Name: "adobe-photoshop", Type: "Outline", Size: "24"}
-	AdobePhotoshopSolid = &Icon{Name: "adobe-photoshop-solid", Type: "Solid", Size: "24"}
-	AdobeXd = &Icon{Name: "adobe-xd", Type: "Outline", Size: "24"}
-	AdobeXdSolid = &Icon{Name: "adobe-xd-solid", Type: "Solid", Size: "24"}
-	AfricanTree = &Icon{Name: "african-tree", Type: "Outline", Size: "24"}
-	Agile = &Icon{Name: "agile", Type: "Outline", Size: "24"}
-	AirConditioner = &Icon{Name: "air-conditioner", Type: "Outline", Size: "24"}
-	Airplane = &Icon{Name: "airplane", Type: "Outline", Size: "24"}
-	AirplaneHelix = &Icon{Name: "airplane-helix", Type: "Outline", Size: "24"}
-	AirplaneHelix45deg = &Icon{Name: "airplane-helix-45deg", Type: "Outline", Size: "24"}
-	AirplaneOff = &Icon{Name: "airplane-off", Type: "Outline", Size: "24"}
-	AirplaneRotation = &Icon{Name: "airplane-rotation", Type: "Outline", Size: "24"}
-	Airplay = &Icon{Name: "airplay", Type: "Outline", Size: "24"}
-	AirplaySolid = &Icon{Name: "airplay-solid", Type: "Solid", Size: "24"}
-	Alarm = &Icon{Name: "alarm", Type: "Outline", Size: "24"}
-	AlarmSolid = &Icon{Name: "alarm-solid", Type: "Solid", Size: "24"}
-	Album = &Icon{Name: "album", Type: "Outline", Size: "24"}
-	AlbumCarousel = &Icon{Name: "album-carousel", Type: "Outline", Size: "24"}
-	AlbumList = &Icon{Name: "album-list", Type: "Outline", Size: "24"}
-	AlbumOpen = &Icon{Name: "album-open", Type: "Outline", Size: "24"}
-	AlignBottomBox = &Icon{Name: "align-bottom-box", Type: "Outline", Size: "24"}
-	AlignBottomBoxSolid = &Icon{Name: "align-bottom-box-solid", Type: "Solid", Size: "24"}
-	AlignCenter = &Icon{Name: "align-center", Type: "Outline", Size: "24"}
-	AlignHorizontalCenters = &Icon{Name: "align-horizontal-centers", Type: "Outline", Size: "24"}
-	AlignHorizontalCentersSolid = &Icon{Name: "align-horizontal-centers-solid", Type: "Solid", Size: "24"}
-	AlignHorizontalSpacing = &Icon{Name: "align-horizontal-spacing", Type: "Outline", Size: "24"}
-	AlignHorizontalSpacingSolid = &Icon{Name: "align-horizontal-spacing-solid", Type: "Solid", Size: "24"}
-	AlignJustify = &Icon{Name: "align-justify", Type: "Outline", Size: "24"}
-	AlignLeft = &Icon{Name: "align-left", Type: "Outline", Size: "24"}
-	AlignLeftBox = &Icon{Name: "align-left-box", Type: "Outline", Size: "24"}
-	AlignLeftBoxSolid = &Icon{Name: "align-left-box-solid", Type: "Solid", Size: "24"}
-	AlignRight = &Icon{Name: "align-right", Type: "Outline", Size: "24"}
-	AlignRightBox = &Icon{Name: "align-right-box", Type: "Outline", Size: "24"}
-	AlignRightBoxSolid = &Icon{Name: "align-right-box-solid", Type: "Solid", Size: "24"}
-	AlignTopBox = &Icon{Name: "align-top-box", Type: "Outline", Size: "24"}
-	AlignTopBoxSolid = &Icon{Name: "align-top-box-solid", Type: "Solid", Size: "24"}
-	AlignVerticalCenters = &Icon{Name: "align-vertical-centers", Type: "Outline", Size: "24"}
-	AlignVerticalCentersSolid = &Icon{Name: "align-vertical-centers-solid", Type: "Solid", Size: "24"}
-	AlignVerticalSpacing = &Icon{Name: "align-vertical-spacing", Type: "Outline", Size: "24"}
-	AlignVerticalSpacingSolid = &Icon{Name: "align-vertical-spacing-solid", Type: "Solid", Size: "24"}
-	AngleTool = &Icon{Name: "angle-tool", Type: "Outline", Size: "24"}
-	Antenna = &Icon{Name: "antenna", Type: "Outline", Size: "24"}
-	AntennaOff = &Icon{Name: "antenna-off", Type: "Outline", Size: "24"}
-	AntennaSignal = &Icon{Name: "antenna-signal", Type: "Outline", Size: "24"}
-	AntennaSignalTag = &Icon{Name: "antenna-signal-tag", Type: "Outline", Size: "24"}
-	AppNotification = &Icon{Name: "app-notification", Type: "Outline", Size: "24"}
-	AppNotificationSolid = &Icon{Name: "app-notification-solid", Type: "Solid", Size: "24"}
-	AppStore = &Icon{Name: "app-store", Type: "Outline", Size: "24"}
-	AppStoreSolid = &Icon{Name: "app-store-solid", Type: "Solid", Size: "24"}
-	AppWindow = &Icon{Name: "app-window", Type: "Outline", Size: "24"}
-	Apple = &Icon{Name: "apple", Type: "Outline", Size: "24"}
-	AppleHalf = &Icon{Name: "apple-half", Type: "Outline", Size: "24"}
-	AppleHalfAlt = &Icon{Name: "apple-half-alt", Type: "Outline", Size: "24"}
-	AppleImac21 = &Icon{Name: "apple-imac-2021", Type: "Outline", Size: "24"}
-	AppleImac21Side = &Icon{Name: "apple-imac-2021-side", Type: "Outline", Size: "24"}
-	AppleMac = &Icon{Name: "apple-mac", Type: "Outline", Size: "24"}
-	AppleShortcuts = &Icon{Name: "apple-shortcuts", Type: "Outline", Size: "24"}
-	AppleShortcutsSolid = &Icon{Name: "apple-shortcuts-solid", Type: "Solid", Size: "24"}
-	AppleSwift = &Icon{Name: "apple-swift", Type: "Outline", Size: "24"}
-	AppleWallet = &Icon{Name: "apple-wallet", Type: "Outline", Size: "24"}
-	ArTag = &Icon{Name: "ar-tag", Type: "Outline", Size: "24"}
-	Arc3d = &Icon{Name: "arc-3d", Type: "Outline", Size: "24"}
-	Arc3dCenterPoint = &Icon{Name: "arc-3d-center-point", Type: "Outline", Size: "24"}
-	Arcade = &Icon{Name: "arcade", Type: "Outline", Size: "24"}
-	Archery = &Icon{Name: "archery", Type: "Outline", Size: "24"}
-	ArcheryMatch = &Icon{Name: "archery-match", Type: "Outline", Size: "24"}
-	Archive = &Icon{Name: "archive", Type: "Outline", Size: "24"}
-	AreaSearch = &Icon{Name: "area-search", Type: "Outline", Size: "24"}
-	ArrowArchery = &Icon{Name: "arrow-archery", Type: "Outline", Size: "24"}
-	ArrowDown = &Icon{Name: "arrow-down", Type: "Outline", Size: "24"}
-	ArrowDownCircle = &Icon{Name: "arrow-down-circle", Type: "Outline", Size: "24"}
-	ArrowDownCircleSolid = &Icon{Name: "arrow-down-circle-solid", Type: "Solid", Size: "24"}
-	ArrowDownLeft = &Icon{Name: "arrow-down-left", Type: "Outline", Size: "24"}
-	ArrowDownLeftCircle = &Icon{Name: "arrow-down-left-circle", Type: "Outline", Size: "24"}
-	ArrowDownLeftCircleSolid = &Icon{Name: "arrow-down-left-circle-solid", Type: "Solid", Size: "24"}
-	ArrowDownLeftSquare = &Icon{Name: "arrow-down-left-square", Type: "Outline", Size: "24"}
-	ArrowDownRight = &Icon{Name: "arrow-down-right", Type: "Outline", Size: "24"}
-	ArrowDownRightCircle = &Icon{Name: "arrow-down-right-circle", Type: "Outline", Size: "24"}
-	ArrowDownRightCircleSolid = &Icon{Name: "arrow-down-right-circle-solid", Type: "Solid", Size: "24"}
-	ArrowDownRightSquare = &Icon{Name: "arrow-down-right-square", Type: "Outline", Size: "24"}
-	ArrowDownRightSquareSolid = &Icon{Name: "arrow-down-right-square-solid", Type: "Solid", Size: "24"}
-	ArrowDownTag = &Icon{Name: "arrow-down-tag", Type: "Outline", Size: "24"}
-	ArrowEmailForward = &Icon{Name: "arrow-email-forward", Type: "Outline", Size: "24"}
-	ArrowEnlargeTag = &Icon{Name: "arrow-enlarge-tag", Type: "Outline", Size: "24"}
-	ArrowLeft = &Icon{Name: "arrow-left", Type: "Outline", Size: "24"}
-	ArrowLeftCircle = &Icon{Name: "arrow-left-circle", Type: "Outline", Size: "24"}
-	ArrowLeftCircleSolid = &Icon{Name: "arrow-left-circle-solid", Type: "Solid", Size: "24"}
-	ArrowLeftTag = &Icon{Name: "arrow-left-tag", Type: "Outline", Size: "24"}
-	ArrowReduceTag = &Icon{Name: "arrow-reduce-tag", Type: "Outline", Size: "24"}
-	ArrowRight = &Icon{Name: "arrow-right", Type: "Outline", Size: "24"}
-	ArrowRightCircle = &Icon{Name: "arrow-right-circle", Type: "Outline", Size: "24"}
-	ArrowRightCircleSolid = &Icon{Name: "arrow-right-circle-solid", Type: "Solid", Size: "24"}
-	ArrowRightTag = &Icon{Name: "arrow-right-tag", Type: "Outline", Size: "24"}
-	ArrowSeparate = &Icon{Name: "arrow-separate", Type: "Outline", Size: "24"}
-	ArrowSeparateVertical = &Icon{Name: "arrow-separate-vertical", Type: "Outline", Size: "24"}
-	ArrowUnion = &Icon{Name: "arrow-union", Type: "Outline", Size: "24"}
-	ArrowUnionVertical = &Icon{Name: "arrow-union-vertical", Type: "Outline", Size: "24"}
-	ArrowUp = &Icon{Name: "arrow-up", Type: "Outline", Size: "24"}
-	ArrowUpCircle = &Icon{Name: "arrow-up-circle", Type: "Outline", Size: "24"}
-	ArrowUpCircleSolid = &Icon{Name: "arrow-up-circle-solid", Type: "Solid", Size: "24"}
-	ArrowUpLeft = &Icon{Name: "arrow-up-left", Type: "Outline", Size: "24"}
-	ArrowUpLeftCircle = &Icon{Name: "arrow-up-left-circle", Type: "Outline", Size: "24"}
-	ArrowUpLeftCircleSolid = &Icon{Name: "arrow-up-left-circle-solid", Type: "Solid", Size: "24"}
-	ArrowUpLeftSquare = &Icon{Name: "arrow-up-left-square", Type: "Outline", Size: "24"}
-	ArrowUpLeftSquareSolid = &Icon{Name: "arrow-up-left-square-solid", Type: "Solid", Size: "24"}
-	ArrowUpRight = &Icon{Name: "arrow-up-right", Type: "Outline", Size: "24"}
-	ArrowUpRightCircle = &Icon{Name: "arrow-up-right-circle", Type: "Outline", Size: "24"}
-	ArrowUpRightCircleSolid = &Icon{Name: "arrow-up-right-circle-solid", Type: "Solid", Size: "24"}
-	ArrowUpRightSquare = &Icon{Name: "arrow-up-right-square", Type: "Outline", Size: "24"}
-	ArrowUpRightSquareSolid = &Icon{Name: "arrow-up-right-square-solid", Type: "Solid", Size: "24"}
-	ArrowUpTag = &Icon{Name: "arrow-up-tag", Type: "Outline", Size: "24"}
-	ArrowsUpFromLine = &Icon{Name: "arrows-up-from-line", Type: "Outline", Size: "24"}
-	Asana = &Icon{Name: "asana", Type: "Outline", Size: "24"}
-	Asterisk = &Icon{Name: "asterisk", Type: "Outline", Size: "24"}
-	AtSign = &Icon{Name: "at-sign", Type: "Outline", Size: "24"}
-	AtSignCircle = &Icon{Name: "at-sign-circle", Type: "Outline", Size: "24"}
-	Atom = &Icon{Name: "atom", Type: "Outline", Size: "24"}
-	Attachment = &Icon{Name: "attachment", Type: "Outline", Size: "24"}
-	AugmentedReality = &Icon{Name: "augmented-reality", Type: "Outline", Size: "24"}
-	AutoFlash = &Icon{Name: "auto-flash", Type: "Outline", Size: "24"}
-	AviFormat = &Icon{Name: "avi-format", Type: "Outline", Size: "24"}
-	Axes = &Icon{Name: "axes", Type: "Outline", Size: "24"}
-	Backward15Seconds = &Icon{Name: "backward-15-seconds", Type: "Outline", Size: "24"}
-	BadgeCheck = &Icon{Name: "badge-check", Type: "Outline", Size: "24"}
-	Bag = &Icon{Name: "bag", Type: "Outline", Size: "24"}
-	Balcony = &Icon{Name: "balcony", Type: "Outline", Size: "24"}
-	Bank = &Icon{Name: "bank", Type: "Outline", Size: "24"}
-	Barcode = &Icon{Name: "barcode", Type: "Outline", Size: "24"}
-	Basketball = &Icon{Name: "basketball", Type: "Outline", Size: "24"}
-	BasketballField = &Icon{Name: "basketball-field", Type: "Outline", Size: "24"}
-	Bathroom = &Icon{Name: "bathroom", Type: "Outline", Size: "24"}
-	BathroomSolid = &Icon{Name: "bathroom-solid", Type: "Solid", Size: "24"}
-	Battery25 = &Icon{Name: "battery-25", Type: "Outline", Size: "24"}
-	Battery50 = &Icon{Name: "battery-50", Type: "Outline", Size: "24"}
-	Battery75 = &Icon{Name: "battery-75", Type: "Outline", Size: "24"}
-	BatteryCharging = &Icon{Name: "battery-charging", Type: "Outline", Size: "24"}
-	BatteryEmpty = &Icon{Name: "battery-empty", Type: "Outline", Size: "24"}
-	BatteryFull = &Icon{Name: "battery-full", Type: "Outline", Size: "24"}
-	BatteryIndicator = &Icon{Name: "battery-indicator", Type: "Outline", Size: "24"}
-	BatterySlash = &Icon{Name: "battery-slash", Type: "Outline", Size: "24"}
-	BatteryWarning = &Icon{Name: "battery-warning", Type: "Outline", Size: "24"}
-	Bbq = &Icon{Name: "bbq", Type: "Outline", Size: "24"}
-	BeachBag = &Icon{Name: "beach-bag", Type: "Outline", Size: "24"}
-	BeachBagBig = &Icon{Name: "beach-bag-big", Type: "Outline", Size: "24"}
-	Bed = &Icon{Name: "bed", Type: "Outline", Size: "24"}
-	BedReady = &Icon{Name: "bed-ready", Type: "Outline", Size: "24"}
-	Behance = &Icon{Name: "behance", Type: "Outline", Size: "24"}
-	BehanceTag = &Icon{Name: "behance-tag", Type: "Outline", Size: "24"}
-	Bell = &Icon{Name: "bell", Type: "Outline", Size: "24"}
-	BellNotification = &Icon{Name: "bell-notification", Type: "Outline", Size: "24"}
-	BellNotificationSolid = &Icon{Name: "bell-notification-solid", Type: "Solid", Size: "24"}
-	BellOff = &Icon{Name: "bell-off", Type: "Outline", Size: "24"}
-	Bicycle = &Icon{Name: "bicycle", Type: "Outline", Size: "24"}
-	Bin = &Icon{Name: "bin", Type: "Outline", Size: "24"}
-	BinFull = &Icon{Name: "bin-full", Type: "Outline", Size: "24"}
-	BinHalf = &Icon{Name: "bin-half", Type: "Outline", Size: "24"}
-	BinMinusIn = &Icon{Name: "bin-minus-in", Type: "Outline", Size: "24"}
-	BinPlusIn = &Icon{Name: "bin-plus-in", Type: "Outline", Size: "24"}
-	Binocular = &Icon{Name: "binocular", Type: "Outline", Size: "24"}
-	BirthdayCake = &Icon{Name: "birthday-cake", Type: "Outline", Size: "24"}
-	Bishop = &Icon{Name: "bishop", Type: "Outline", Size: "24"}
-	Bitbucket = &Icon{Name: "bitbucket", Type: "Outline", Size: "24"}
-	BitcoinCircle = &Icon{Name: "bitcoin-circle", Type: "Outline", Size: "24"}
-	BitcoinCircleSolid = &Icon{Name: "bitcoin-circle-solid", Type: "Solid", Size: "24"}
-	BitcoinRotateOut = &Icon{Name: "bitcoin-rotate-out", Type: "Outline", Size: "24"}
-	Bluetooth = &Icon{Name: "bluetooth", Type: "Outline", Size: "24"}
-	BluetoothTag = &Icon{Name: "bluetooth-tag", Type: "Outline", Size: "24"}
-	BluetoothTagSolid = &Icon{Name: "bluetooth-tag-solid", Type: "Solid", Size: "24"}
-	Bold = &Icon{Name: "bold", Type: "Outline", Size: "24"}
-	BoldSquare = &Icon{Name: "bold-square", Type: "Outline", Size: "24"}
-	BoldSquareSolid = &Icon{Name: "bold-square-solid", Type: "Solid", Size: "24"}
-	Bonfire = &Icon{Name: "bonfire", Type: "Outline", Size: "24"}
-	Book = &Icon{Name: "book", Type: "Outline", Size: "24"}
-	BookLock = &Icon{Name: "book-lock", Type: "Outline", Size: "24"}
-	BookSolid = &Icon{Name: "book-solid", Type: "Solid", Size: "24"}
-	BookStack = &Icon{Name: "book-stack", Type: "Outline", Size: "24"}
-	Bookmark = &Icon{Name: "bookmark", Type: "Outline", Size: "24"}
-	BookmarkBook = &Icon{Name: "bookmark-book", Type: "Outline", Size: "24"}
-	BookmarkCircle = &Icon{Name: "bookmark-circle", Type: "Outline", Size: "24"}
-	BookmarkCircleSolid = &Icon{Name: "bookmark-circle-solid", Type: "Solid", Size: "24"}
-	BookmarkSolid = &Icon{Name: "bookmark-solid", Type: "Solid", Size: "24"}
-	BorderBl = &Icon{Name: "border-bl", Type: "Outline", Size: "24"}
-	BorderBottom = &Icon{Name: "border-bottom", Type: "Outline", Size: "24"}
-	BorderBr = &Icon{Name: "border-br", Type: "Outline", Size: "24"}
-	BorderInner = &Icon{Name: "border-inner", Type: "Outline", Size: "24"}
-	BorderLeft = &Icon{Name: "border-left", Type: "Outline", Size: "24"}
-	BorderOut = &Icon{Name: "border-out", Type: "Outline", Size: "24"}
-	BorderRight = &Icon{Name: "border-right", Type: "Outline", Size: "24"}
-	BorderTl = &Icon{Name: "border-tl", Type: "Outline", Size: "24"}
-	BorderTop = &Icon{Name: "border-top", Type: "Outline", Size: "24"}
-	BorderTr = &Icon{Name: "border-tr", Type: "Outline", Size: "24"}
-	BounceLeft = &Icon{Name: "bounce-left", Type: "Outline", Size: "24"}
-	BounceRight = &Icon{Name: "bounce-right", Type: "Outline", Size: "24"}
-	BowlingBall = &Icon{Name: "bowling-ball", Type: "Outline", Size: "24"}
-	Box = &Icon{Name: "box", Type: "Outline", Size: "24"}
-	Box3dCenter = &Icon{Name: "box-3d-center", Type: "Outline", Size: "24"}
-	Box3dPoint = &Icon{Name: "box-3d-point", Type: "Outline", Size: "24"}
-	Box3dThreePoints = &Icon{Name: "box-3d-three-points", Type: "Outline", Size: "24"}
-	BoxIso = &Icon{Name: "box-iso", Type: "Outline", Size: "24"}
-	BoxingGlove = &Icon{Name: "boxing-glove", Type: "Outline", Size: "24"}
-	Brain = &Icon{Name: "brain", Type: "Outline", Size: "24"}
-	BrainElectricity = &Icon{Name: "brain-electricity", Type: "Outline", Size: "24"}
-	BrainResearch = &Icon{Name: "brain-research", Type: "Outline", Size: "24"}
-	BrainWarning = &Icon{Name: "brain-warning", Type: "Outline", Size: "24"}
-	BreadSlice = &Icon{Name: "bread-slice", Type: "Outline", Size: "24"}
-	Bridge3d = &Icon{Name: "bridge-3d", Type: "Outline", Size: "24"}
-	BridgeSurface = &Icon{Name: "bridge-surface", Type: "Outline", Size: "24"}
-	BrightCrown = &Icon{Name: "bright-crown", Type: "Outline", Size: "24"}
-	BrightStar = &Icon{Name: "bright-star", Type: "Outline", Size: "24"}
-	Brightness = &Icon{Name: "brightness", Type: "Outline", Size: "24"}
-	BrightnessWindow = &Icon{Name: "brightness-window", Type: "Outline", Size: "24"}
-	BubbleDownload = &Icon{Name: "bubble-download", Type: "Outline", Size: "24"}
-	BubbleIncome = &Icon{Name: "bubble-income", Type: "Outline", Size: "24"}
-	BubbleOutcome = &Icon{Name: "bubble-outcome", Type: "Outline", Size: "24"}
-	BubbleSearch = &Icon{Name: "bubble-search", Type: "Outline", Size: "24"}
-	BubbleSearchSolid = &Icon{Name: "bubble-search-solid", Type: "Solid", Size: "24"}
-	BubbleStar = &Icon{Name: "bubble-star", Type: "Outline", Size: "24"}
-	BubbleUpload = &Icon{Name: "bubble-upload", Type: "Outline", Size: "24"}
-	BubbleWarning = &Icon{Name: "bubble-warning", Type: "Outline", Size: "24"}
-	BubbleXmark = &Icon{Name: "bubble-xmark", Type: "Outline", Size: "24"}
-	BubbleXmarkSolid = &Icon{Name: "bubble-xmark-solid", Type: "Solid", Size: "24"}
-	Building = &Icon{Name: "building", Type: "Outline", Size: "24"}
-	Bus = &Icon{Name: "bus", Type: "Outline", Size: "24"}
-	BusGreen = &Icon{Name: "bus-green", Type: "Outline", Size: "24"}
-	BusStop = &Icon{Name: "bus-stop", Type: "Outline", Size: "24"}
-	CSquare = &Icon{Name: "c-square", Type: "Outline", Size: "24"}
-	CableTag = &Icon{Name: "cable-tag", Type: "Outline", Size: "24"}
-	CableTagSolid = &Icon{Name: "cable-tag-solid", Type: "Solid", Size: "24"}
-	Calculator = &Icon{Name: "calculator", Type: "Outline", Size: "24"}
-	Calendar = &Icon{Name: "calendar", Type: "Outline", Size: "24"}
-	CalendarArrowDown = &Icon{Name: "calendar-arrow-down", Type: "Outline", Size: "24"}
-	CalendarArrowDownSolid = &Icon{Name: "calendar-arrow-down-solid", Type: "Solid", Size: "24"}
-	CalendarArrowUp = &Icon{Name: "calendar-arrow-up", Type: "Outline", Size: "24"}
-	CalendarArrowUpSolid = &Icon{Name: "calendar-arrow-up-solid", Type: "Solid", Size: "24"}
-	CalendarCheck = &Icon{Name: "calendar-check", Type: "Outline", Size: "24"}
-	CalendarCheckSolid = &Icon{Name: "calendar-check-solid", Type: "Solid", Size: "24"}
-	CalendarMinus = &Icon{Name: "calendar-minus", Type: "Outline", Size: "24"}
-	CalendarMinusSolid = &Icon{Name: "calendar-minus-solid", Type: "Solid", Size: "24"}
-	CalendarPlus = &Icon{Name: "calendar-plus", Type: "Outline", Size: "24"}
-	CalendarPlusSolid = &Icon{Name: "calendar-plus-solid", Type: "Solid", Size: "24"}
-	CalendarRotate = &Icon{Name: "calendar-rotate", Type: "Outline", Size: "24"}
-	CalendarRotateSolid = &Icon{Name: "calendar-rotate-solid", Type: "Solid", Size: "24"}
-	CalendarXmark = &Icon{Name: "calendar-xmark", Type: "Outline", Size: "24"}
-	CalendarXmarkSolid = &Icon{Name: "calendar-xmark-solid", Type: "Solid", Size: "24"}
-	Camera = &Icon{Name: "camera", Type: "Outline", Size: "24"}
-	CameraSolid = &Icon{Name: "camera-solid", Type: "Solid", Size: "24"}
-	CandlestickChart = &Icon{Name: "candlestick-chart", Type: "Outline", Size: "24"}
-	Car = &Icon{Name: "car", Type: "Outline", Size: "24"}
-	CardLock = &Icon{Name: "card-lock", Type: "Outline", Size: "24"}
-	CardNoAccess = &Icon{Name: "card-no-access", Type: "Outline", Size: "24"}
-	CardReader = &Icon{Name: "card-reader", Type: "Outline", Size: "24"}
-	CardShield = &Icon{Name: "card-shield", Type: "Outline", Size: "24"}
-	CardWallet = &Icon{Name: "card-wallet", Type: "Outline", Size: "24"}
-	Cart = &Icon{Name: "cart", Type: "Outline", Size: "24"}
-	CartAlt = &Icon{Name: "cart-alt", Type: "Outline", Size: "24"}
-	CartMinus = &Icon{Name: "cart-minus", Type: "Outline", Size: "24"}
-	CartPlus = &Icon{Name: "cart-plus", Type: "Outline", Size: "24"}
-	Cash = &Icon{Name: "cash", Type: "Outline", Size: "24"}
-	CashSolid = &Icon{Name: "cash-solid", Type: "Solid", Size: "24"}
-	Cell2x2 = &Icon{Name: "cell-2x2", Type: "Outline", Size: "24"}
-	Cellar = &Icon{Name: "cellar", Type: "Outline", Size: "24"}
-	CenterAlign = &Icon{Name: "center-align", Type: "Outline", Size: "24"}
-	CenterAlignSolid = &Icon{Name: "center-align-solid", Type: "Solid", Size: "24"}
-	ChatBubble = &Icon{Name: "chat-bubble", Type: "Outline", Size: "24"}
-	ChatBubbleCheck = &Icon{Name: "chat-bubble-check", Type: "Outline", Size: "24"}
-	ChatBubbleCheckSolid = &Icon{Name: "chat-bubble-check-solid", Type: "Solid", Size: "24"}
-	ChatBubbleEmpty = &Icon{Name: "chat-bubble-empty", Type: "Outline", Size: "24"}
-	ChatBubbleEmptySolid = &Icon{Name: "chat-bubble-empty-solid", Type: "Solid", Size: "24"}
-	ChatBubbleQuestion = &Icon{Name: "chat-bubble-question", Type: "Outline", Size: "24"}
-	ChatBubbleQuestionSolid = &Icon{Name: "chat-bubble-question-solid", Type: "Solid", Size: "24"}
-	ChatBubbleSolid = &Icon{Name: "chat-bubble-solid", Type: "Solid", Size: "24"}
-	ChatBubbleTranslate = &Icon{Name: "chat-bubble-translate", Type: "Outline", Size: "24"}
-	ChatBubbleTranslateSolid = &Icon{Name: "chat-bubble-translate-solid", Type: "Solid", Size: "24"}
-	ChatBubbleWarning = &Icon{Name: "chat-bubble-warning", Type: "Outline", Size: "24"}
-	ChatBubbleWarningSolid = &Icon{Name: "chat-bubble-warning-solid", Type: "Solid", Size: "24"}
-	ChatBubbleXmark = &Icon{Name: "chat-bubble-xmark", Type: "Outline", Size: "24"}
-	ChatBubbleXmarkSolid = &Icon{Name: "chat-bubble-xmark-solid", Type: "Solid", Size: "24"}
-	ChatLines = &Icon{Name: "chat-lines", Type: "Outline", Size: "24"}
-	ChatLinesSolid = &Icon{Name: "chat-lines-solid", Type: "Solid", Size: "24"}
-	ChatMinusIn = &Icon{Name: "chat-minus-in", Type: "Outline", Size: "24"}
-	ChatMinusInSolid = &Icon{Name: "chat-minus-in-solid", Type: "Solid", Size: "24"}
-	ChatPlusIn = &Icon{Name: "chat-plus-in", Type: "Outline", Size: "24"}
-	ChatPlusInSolid = &Icon{Name: "chat-plus-in-solid", Type: "Solid", Size: "24"}
-	Check = &Icon{Name: "check", Type: "Outline", Size: "24"}
-	CheckCircle = &Icon{Name: "check-circle", Type: "Outline", Size: "24"}
-	CheckCircleSolid = &Icon{Name: "check-circle-solid", Type: "Solid", Size: "24"}
-	CheckSquare = &Icon{Name: "check-square", Type: "Outline", Size: "24"}
-	CheckSquareSolid = &Icon{Name: "check-square-solid", Type: "Solid", Size: "24"}
-	Chocolate = &Icon{Name: "chocolate", Type: "Outline", Size: "24"}
-	Chromecast = &Icon{Name: "chromecast", Type: "Outline", Size: "24"}
-	ChromecastActive = &Icon{Name: "chromecast-active", Type: "Outline", Size: "24"}
-	Church = &Icon{Name: "church", Type: "Outline", Size: "24"}
-	ChurchSide = &Icon{Name: "church-side", Type: "Outline", Size: "24"}
-	CigaretteSlash = &Icon{Name: "cigarette-slash", Type: "Outline", Size: "24"}
-	CinemaOld = &Icon{Name: "cinema-old", Type: "Outline", Size: "24"}
-	Circle = &Icon{Name: "circle", Type: "Outline", Size: "24"}
-	CircleSpark = &Icon{Name: "circle-spark", Type: "Outline", Size: "24"}
-	City = &Icon{Name: "city", Type: "Outline", Size: "24"}
-	ClipboardCheck = &Icon{Name: "clipboard-check", Type: "Outline", Size: "24"}
-	Clock = &Icon{Name: "clock", Type: "Outline", Size: "24"}
-	ClockRotateRight = &Icon{Name: "clock-rotate-right", Type: "Outline", Size: "24"}
-	ClockSolid = &Icon{Name: "clock-solid", Type: "Solid", Size: "24"}
-	ClosedCaptionsTag = &Icon{Name: "closed-captions-tag", Type: "Outline", Size: "24"}
-	ClosedCaptionsTagSolid = &Icon{Name: "closed-captions-tag-solid", Type: "Solid", Size: "24"}
-	Closet = &Icon{Name: "closet", Type: "Outline", Size: "24"}
-	Cloud = &Icon{Name: "cloud", Type: "Outline", Size: "24"}
-	CloudBookmark = &Icon{Name: "cloud-bookmark", Type: "Outline", Size: "24"}
-	CloudCheck = &Icon{Name: "cloud-check", Type: "Outline", Size: "24"}
-	CloudDesync = &Icon{Name: "cloud-desync", Type: "Outline", Size: "24"}
-	CloudDownload = &Icon{Name: "cloud-download", Type: "Outline", Size: "24"}
-	CloudSquare = &Icon{Name: "cloud-square", Type: "Outline", Size: "24"}
-	CloudSquareSolid = &Icon{Name: "cloud-square-solid", Type: "Solid", Size: "24"}
-	CloudSunny = &Icon{Name: "cloud-sunny", Type: "Outline", Size: "24"}
-	CloudSync = &Icon{Name: "cloud-sync", Type: "Outline", Size: "24"}
-	CloudUpload = &Icon{Name: "cloud-upload", Type: "Outline", Size: "24"}
-	CloudXmark = &Icon{Name: "cloud-xmark", Type: "Outline", Size: "24"}
-	Code = &Icon{Name: "code", Type: "Outline", Size: "24"}
-	CodeBrackets = &Icon{Name: "code-brackets", Type: "Outline", Size: "24"}
-	CodeBracketsSquare = &Icon{Name: "code-brackets-square", Type: "Outline", Size: "24"}
-	Codepen = &Icon{Name: "codepen", Type: "Outline", Size: "24"}
-	CoffeeCup = &Icon{Name: "coffee-cup", Type: "Outline", Size: "24"}
-	CoinSlash = &Icon{Name: "coin-slash", Type: "Outline", Size: "24"}
-	Coins = &Icon{Name: "coins", Type: "Outline", Size: "24"}
-	CoinsSwap = &Icon{Name: "coins-swap", Type: "Outline", Size: "24"}
-	CollageFrame = &Icon{Name: "collage-frame", Type: "Outline", Size: "24"}
-	Collapse = &Icon{Name: "collapse", Type: "Outline", Size: "24"}
-	ColorFilter = &Icon{Name: "color-filter", Type: "Outline", Size: "24"}
-	ColorPicker = &Icon{Name: "color-picker", Type: "Outline", Size: "24"}
-	ColorPickerEmpty = &Icon{Name: "color-picker-empty", Type: "Outline", Size: "24"}
-	ColorWheel = &Icon{Name: "color-wheel", Type: "Outline", Size: "24"}
-	Combine = &Icon{Name: "combine", Type: "Outline", Size: "24"}
-	Commodity = &Icon{Name: "commodity", Type: "Outline", Size: "24"}
-	Community = &Icon{Name: "community", Type: "Outline", Size: "24"}
-	CompAlignBottom = &Icon{Name: "comp-align-bottom", Type: "Outline", Size: "24"}
-	CompAlignBottomSolid = &Icon{Name: "comp-align-bottom-solid", Type: "Solid", Size: "24"}
-	CompAlignLeft = &Icon{Name: "comp-align-left", Type: "Outline", Size: "24"}
-	CompAlignLeftSolid = &Icon{Name: "comp-align-left-solid", Type: "Solid", Size: "24"}
-	CompAlignRight = &Icon{Name: "comp-align-right", Type: "Outline", Size: "24"}
-	CompAlignRightSolid = &Icon{Name: "comp-align-right-solid", Type: "Solid", Size: "24"}
-	CompAlignTop = &Icon{Name: "comp-align-top", Type: "Outline", Size: "24"}
-	CompAlignTopSolid = &Icon{Name: "comp-align-top-solid", Type: "Solid", Size: "24"}
-	CompactDisc = &Icon{Name: "compact-disc", Type: "Outline", Size: "24"}
-	Compass = &Icon{Name: "compass", Type: "Outline", Size: "24"}
-	Component = &Icon{Name: "component", Type: "Outline", Size: "24"}
-	ComponentSolid = &Icon{Name: "component-solid", Type: "Solid", Size: "24"}
-	Compress = &Icon{Name: "compress", Type: "Outline", Size: "24"}
-	CompressLines = &Icon{Name: "compress-lines", Type: "Outline", Size: "24"}
-	Computer = &Icon{Name: "computer", Type: "Outline", Size: "24"}
-	ConstrainedSurface = &Icon{Name: "constrained-surface", Type: "Outline", Size: "24"}
-	Consumable = &Icon{Name: "consumable", Type: "Outline", Size: "24"}
-	Contactless = &Icon{Name: "contactless", Type: "Outline", Size: "24"}
-	ControlSlider = &Icon{Name: "control-slider", Type: "Outline", Size: "24"}
-	Cookie = &Icon{Name: "cookie", Type: "Outline", Size: "24"}
-	CoolingSquare = &Icon{Name: "cooling-square", Type: "Outline", Size: "24"}
-	CoolingSquareSolid = &Icon{Name: "cooling-square-solid", Type: "Solid", Size: "24"}
-	Copy = &Icon{Name: "copy", Type: "Outline", Size: "24"}
-	Copyright = &Icon{Name: "copyright", Type: "Outline", Size: "24"}
-	CornerBottomLeft = &Icon{Name: "corner-bottom-left", Type: "Outline", Size: "24"}
-	CornerBottomRight = &Icon{Name: "corner-bottom-right", Type: "Outline", Size: "24"}
-	CornerTopLeft = &Icon{Name: "corner-top-left", Type: "Outline", Size: "24"}
-	CornerTopRight = &Icon{Name: "corner-top-right", Type: "Outline", Size: "24"}
-	Cpu = &Icon{Name: "cpu", Type: "Outline", Size: "24"}
-	CpuWarning = &Icon{Name: "cpu-warning", Type: "Outline", Size: "24"}
-	CrackedEgg = &Icon{Name: "cracked-egg", Type: "Outline", Size: "24"}
-	CreativeCommons = &Icon{Name: "creative-commons", Type: "Outline", Size: "24"}
-	CreditCard = &Icon{Name: "credit-card", Type: "Outline", Size: "24"}
-	CreditCard2 = &Icon{Name: "credit-card-2", Type: "Outline", Size: "24"}
-	CreditCardSlash = &Icon{Name: "credit-card-slash", Type: "Outline", Size: "24"}
-	CreditCardSolid = &Icon{Name: "credit-card-solid", Type: "Solid", Size: "24"}
-	CreditCards = &Icon{Name: "credit-cards", Type: "Outline", Size: "24"}
-	Crib = &Icon{Name: "crib", Type: "Outline", Size: "24"}
-	Crop = &Icon{Name: "crop", Type: "Outline", Size: "24"}
-	CropRotateBl = &Icon{Name: "crop-rotate-bl", Type: "Outline", Size: "24"}
-	CropRotateBr = &Icon{Name: "crop-rotate-br", Type: "Outline", Size: "24"}
-	CropRotateTl = &Icon{Name: "crop-rotate-tl", Type: "Outline", Size: "24"}
-	CropRotateTr = &Icon{Name: "crop-rotate-tr", Type: "Outline", Size: "24"}
-	Crown = &Icon{Name: "crown", Type: "Outline", Size: "24"}
-	CrownCircle = &Icon{Name: "crown-circle", Type: "Outline", Size: "24"}
-	Css3 = &Icon{Name: "css3", Type: "Outline", Size: "24"}
-	Cube = &Icon{Name: "cube", Type: "Outline", Size: "24"}
-	CubeBandage = &Icon{Name: "cube-bandage", Type: "Outline", Size: "24"}
-	CubeCutWithCurve = &Icon{Name: "cube-cut-with-curve", Type: "Outline", Size: "24"}
-	CubeDots = &Icon{Name: "cube-dots", Type: "Outline", Size: "24"}
-	CubeDotsSolid = &Icon{Name: "cube-dots-solid", Type: "Solid", Size: "24"}
-	CubeHole = &Icon{Name: "cube-hole", Type: "Outline", Size: "24"}
-	CubeReplaceFace = &Icon{Name: "cube-replace-face", Type: "Outline", Size: "24"}
-	CubeScan = &Icon{Name: "cube-scan", Type: "Outline", Size: "24"}
-	CubeScanSolid = &Icon{Name: "cube-scan-solid", Type: "Solid", Size: "24"}
-	CursorPointer = &Icon{Name: "cursor-pointer", Type: "Outline", Size: "24"}
-	CurveArray = &Icon{Name: "curve-array", Type: "Outline", Size: "24"}
-	Cut = &Icon{Name: "cut", Type: "Outline", Size: "24"}
-	CutAlt = &Icon{Name: "cut-alt", Type: "Outline", Size: "24"}
-	Cutlery = &Icon{Name: "cutlery", Type: "Outline", Size: "24"}
-	Cycling = &Icon{Name: "cycling", Type: "Outline", Size: "24"}
-	Cylinder = &Icon{Name: "cylinder", Type: "Outline", Size: "24"}
-	DashFlag = &Icon{Name: "dash-flag", Type: "Outline", Size: "24"}
-	Dashboard = &Icon{Name: "dashboard", Type: "Outline", Size: "24"}
-	DashboardDots = &Icon{Name: "dashboard-dots", Type: "Outline", Size: "24"}
-	DashboardSpeed = &Icon{Name: "dashboard-speed", Type: "Outline", Size: "24"}
-	DataTransferBoth = &Icon{Name: "data-transfer-both", Type: "Outline", Size: "24"}
-	DataTransferCheck = &Icon{Name: "data-transfer-check", Type: "Outline", Size: "24"}
-	DataTransferDown = &Icon{Name: "data-transfer-down", Type: "Outline", Size: "24"}
-	DataTransferUp = &Icon{Name: "data-transfer-up", Type: "Outline", Size: "24"}
-	DataTransferWarning = &Icon{Name: "data-transfer-warning", Type: "Outline", Size: "24"}
-	Database = &Icon{Name: "database", Type: "Outline", Size: "24"}
-	DatabaseBackup = &Icon{Name: "database-backup", Type: "Outline", Size: "24"}
-	DatabaseCheck = &Icon{Name: "database-check", Type: "Outline", Size: "24"}
-	DatabaseCheckSolid = &Icon{Name: "database-check-solid", Type: "Solid", Size: "24"}
-	DatabaseExport = &Icon{Name: "database-export", Type: "Outline", Size: "24"}
-	DatabaseMonitor = &Icon{Name: "database-monitor", Type: "Outline", Size: "24"}
-	DatabaseRestore = &Icon{Name: "database-restore", Type: "Outline", Size: "24"}
-	DatabaseScript = &Icon{Name: "database-script", Type: "Outline", Size: "24"}
-	DatabaseScriptMinus = &Icon{Name: "database-script-minus", Type: "Outline", Size: "24"}
-	DatabaseScriptPlus = &Icon{Name: "database-script-plus", Type: "Outline", Size: "24"}
-	DatabaseSearch = &Icon{Name: "database-search", Type: "Outline", Size: "24"}
-	DatabaseSettings = &Icon{Name: "database-settings", Type: "Outline", Size: "24"}
-	DatabaseSolid = &Icon{Name: "database-solid", Type: "Solid", Size: "24"}
-	DatabaseStar = &Icon{Name: "database-star", Type: "Outline", Size: "24"}
-	DatabaseStats = &Icon{Name: "database-stats", Type: "Outline", Size: "24"}
-	DatabaseTag = &Icon{Name: "database-tag", Type: "Outline", Size: "24"}
-	DatabaseTagSolid = &Icon{Name: "database-tag-solid", Type: "Solid", Size: "24"}
-	DatabaseWarning = &Icon{Name: "database-warning", Type: "Outline", Size: "24"}
-	DatabaseXmark = &Icon{Name: "database-xmark", Type: "Outline", Size: "24"}
-	DatabaseXmarkSolid = &Icon{Name: "database-xmark-solid", Type: "Solid", Size: "24"}
-	DbStar = &Icon{Name: "db-star", Type: "Outline", Size: "24"}
-	DeCompress = &Icon{Name: "de-compress", Type: "Outline", Size: "24"}
-	Delivery = &Icon{Name: "delivery", Type: "Outline", Size: "24"}
-	DeliveryTruck = &Icon{Name: "delivery-truck", Type: "Outline", Size: "24"}
-	Depth = &Icon{Name: "depth", Type: "Outline", Size: "24"}
-	DesignNib = &Icon{Name: "design-nib", Type: "Outline", Size: "24"}
-	DesignNibSolid = &Icon{Name: "design-nib-solid", Type: "Solid", Size: "24"}
-	DesignPencil = &Icon{Name: "design-pencil", Type: "Outline", Size: "24"}
-	Desk = &Icon{Name: "desk", Type: "Outline", Size: "24"}
-	Developer = &Icon{Name: "developer", Type: "Outline", Size: "24"}
-	DewPoint = &Icon{Name: "dew-point", Type: "Outline", Size: "24"}
-	Dialpad = &Icon{Name: "dialpad", Type: "Outline", Size: "24"}
-	Diameter = &Icon{Name: "diameter", Type: "Outline", Size: "24"}
-	DiameterSolid = &Icon{Name: "diameter-solid", Type: "Solid", Size: "24"}
-	DiceFive = &Icon{Name: "dice-five", Type: "Outline", Size: "24"}
-	DiceFour = &Icon{Name: "dice-four", Type: "Outline", Size: "24"}
-	DiceOne = &Icon{Name: "dice-one", Type: "Outline", Size: "24"}
-	DiceSix = &Icon{Name: "dice-six", Type: "Outline", Size: "24"}
-	DiceThree = &Icon{Name: "dice-three", Type: "Outline", Size: "24"}
-	DiceTwo = &Icon{Name: "dice-two", Type: "Outline", Size: "24"}
-	DimmerSwitch = &Icon{Name: "dimmer-switch", Type: "Outline", Size: "24"}
-	DirectorChair = &Icon{Name: "director-chair", Type: "Outline", Size: "24"}
-	Discord = &Icon{Name: "discord", Type: "Outline", Size: "24"}
-	Dishwasher = &Icon{Name: "dishwasher", Type: "Outline", Size: "24"}
-	Display4k = &Icon{Name: "display-4k", Type: "Outline", Size: "24"}
-	Divide = &Icon{Name: "divide", Type: "Outline", Size: "24"}
-	DivideThree = &Icon{Name: "divide-three", Type: "Outline", Size: "24"}
-	Dna = &Icon{Name: "dna", Type: "Outline", Size: "24"}
-	Dns = &Icon{Name: "dns", Type: "Outline", Size: "24"}
-	DocMagnifyingGlass = &Icon{Name: "doc-magnifying-glass", Type: "Outline", Size: "24"}
-	DocMagnifyingGlassIn = &Icon{Name: "doc-magnifying-glass-in", Type: "Outline", Size: "24"}
-	DocStar = &Icon{Name: "doc-star", Type: "Outline", Size: "24"}
-	DocStarIn = &Icon{Name: "doc-star-in", Type: "Outline", Size: "24"}
-	DogecoinCircle = &Icon{Name: "dogecoin-circle", Type: "Outline", Size: "24"}
-	DogecoinCircleSolid = &Icon{Name: "dogecoin-circle-solid", Type: "Solid", Size: "24"}
-	DogecoinRotateOut = &Icon{Name: "dogecoin-rotate-out", Type: "Outline", Size: "24"}
-	Dollar = &Icon{Name: "dollar", Type: "Outline", Size: "24"}
-	DollarCircle = &Icon{Name: "dollar-circle", Type: "Outline", Size: "24"}
-	DollarCircleSolid = &Icon{Name: "dollar-circle-solid", Type: "Solid", Size: "24"}
-	DomoticWarning = &Icon{Name: "domotic-warning", Type: "Outline", Size: "24"}
-	Donate = &Icon{Name: "donate", Type: "Outline", Size: "24"}
-	DotArrowDown = &Icon{Name: "dot-arrow-down", Type: "Outline", Size: "24"}
-	DotArrowLeft = &Icon{Name: "dot-arrow-left", Type: "Outline", Size: "24"}
-	DotArrowRight = &Icon{Name: "dot-arrow-right", Type: "Outline", Size: "24"}
-	DotArrowUp = &Icon{Name: "dot-arrow-up", Type: "Outline", Size: "24"}
-	DoubleCheck = &Icon{Name: "double-check", Type: "Outline", Size: "24"}
-	Download = &Icon{Name: "download", Type: "Outline", Size: "24"}
-	DownloadCircle = &Icon{Name: "download-circle", Type: "Outline", Size: "24"}
-	DownloadCircleSolid = &Icon{Name: "download-circle-solid", Type: "Solid", Size: "24"}
-	DownloadDataWindow = &Icon{Name: "download-data-window", Type: "Outline", Size: "24"}
-	DownloadSquare = &Icon{Name: "download-square", Type: "Outline", Size: "24"}
-	DownloadSquareSolid = &Icon{Name: "download-square-solid", Type: "Solid", Size: "24"}
-	Drag = &Icon{Name: "drag", Type: "Outline", Size: "24"}
-	DragHandGesture = &Icon{Name: "drag-hand-gesture", Type: "Outline", Size: "24"}
-	Drawer = &Icon{Name: "drawer", Type: "Outline", Size: "24"}
-	Dribbble = &Icon{Name: "dribbble", Type: "Outline", Size: "24"}
-	Drone = &Icon{Name: "drone", Type: "Outline", Size: "24"}
-	DroneChargeFull = &Icon{Name: "drone-charge-full", Type: "Outline", Size: "24"}
-	DroneChargeHalf = &Icon{Name: "drone-charge-half", Type: "Outline", Size: "24"}
-	DroneChargeLow = &Icon{Name: "drone-charge-low", Type: "Outline", Size: "24"}
-	DroneCheck = &Icon{Name: "drone-check", Type: "Outline", Size: "24"}
-	DroneLanding = &Icon{Name: "drone-landing", Type: "Outline", Size: "24"}
-	DroneRefresh = &Icon{Name: "drone-refresh", Type: "Outline", Size: "24"}
-	DroneTakeOff = &Icon{Name: "drone-take-off", Type: "Outline", Size: "24"}
-	DroneXmark = &Icon{Name: "drone-xmark", Type: "Outline", Size: "24"}
-	Droplet = &Icon{Name: "droplet", Type: "Outline", Size: "24"}
-	DropletCheck = &Icon{Name: "droplet-check", Type: "Outline", Size: "24"}
-	DropletHalf = &Icon{Name: "droplet-half", Type: "Outline", Size: "24"}
-	DropletSnowFlakeIn = &Icon{Name: "droplet-snow-flake-in", Type: "Outline", Size: "24"}
-	DropletSnowFlakeInSolid = &Icon{Name: "droplet-snow-flake-in-solid", Type: "Solid", Size: "24"}
-	DropletSolid = &Icon{Name: "droplet-solid", Type: "Solid", Size: "24"}
-	EaseCurveControlPoints = &Icon{Name: "ease-curve-control-points", Type: "Outline", Size: "24"}
-	EaseIn = &Icon{Name: "ease-in", Type: "Outline", Size: "24"}
-	EaseInControlPoint = &Icon{Name: "ease-in-control-point", Type: "Outline", Size: "24"}
-	EaseInOut = &Icon{Name: "ease-in-out", Type: "Outline", Size: "24"}
-	EaseOut = &Icon{Name: "ease-out", Type: "Outline", Size: "24"}
-	EaseOutControlPoint = &Icon{Name: "ease-out-control-point", Type: "Outline", Size: "24"}
-	EcologyBook = &Icon{Name: "ecology-book", Type: "Outline", Size: "24"}
-	Edit = &Icon{Name: "edit", Type: "Outline", Size: "24"}
-	EditPencil = &Icon{Name: "edit-pencil", Type: "Outline", Size: "24"}
-	Egg = &Icon{Name: "egg", Type: "Outline", Size: "24"}
-	Eject = &Icon{Name: "eject", Type: "Outline", Size: "24"}
-	ElectronicsChip = &Icon{Name: "electronics-chip", Type: "Outline", Size: "24"}
-	ElectronicsTransistor = &Icon{Name: "electronics-transistor", Type: "Outline", Size: "24"}
-	Elevator = &Icon{Name: "elevator", Type: "Outline", Size: "24"}
-	Ellipse3d = &Icon{Name: "ellipse-3d", Type: "Outline", Size: "24"}
-	Ellipse3dThreePoints = &Icon{Name: "ellipse-3d-three-points", Type: "Outline", Size: "24"}
-	Emoji = &Icon{Name: "emoji", Type: "Outline", Size: "24"}
-	EmojiBall = &Icon{Name: "emoji-ball", Type: "Outline", Size: "24"}
-	EmojiBlinkLeft = &Icon{Name: "emoji-blink-left", Type: "Outline", Size: "24"}
-	EmojiBlinkRight = &Icon{Name: "emoji-blink-right", Type: "Outline", Size: "24"}
-	EmojiLookDown = &Icon{Name: "emoji-look-down", Type: "Outline", Size: "24"}
-	EmojiLookLeft = &Icon{Name: "emoji-look-left", Type: "Outline", Size: "24"}
-	EmojiLookRight = &Icon{Name: "emoji-look-right", Type: "Outline", Size: "24"}
-	EmojiLookUp = &Icon{Name: "emoji-look-up", Type: "Outline", Size: "24"}
-	EmojiPuzzled = &Icon{Name: "emoji-puzzled", Type: "Outline", Size: "24"}
-	EmojiQuite = &Icon{Name: "emoji-quite", Type: "Outline", Size: "24"}
-	EmojiReally = &Icon{Name: "emoji-really", Type: "Outline", Size: "24"}
-	EmojiSad = &Icon{Name: "emoji-sad", Type: "Outline", Size: "24"}
-	EmojiSatisfied = &Icon{Name: "emoji-satisfied", Type: "Outline", Size: "24"}
-	EmojiSingLeft = &Icon{Name: "emoji-sing-left", Type: "Outline", Size: "24"}
-	EmojiSingLeftNote = &Icon{Name: "emoji-sing-left-note", Type: "Outline", Size: "24"}
-	EmojiSingRight = &Icon{Name: "emoji-sing-right", Type: "Outline", Size: "24"}
-	EmojiSingRightNote = &Icon{Name: "emoji-sing-right-note", Type: "Outline", Size: "24"}
-	EmojiSurprise = &Icon{Name: "emoji-surprise", Type: "Outline", Size: "24"}
-	EmojiSurpriseAlt = &Icon{Name: "emoji-surprise-alt", Type: "Outline", Size: "24"}
-	EmojiTalkingAngry = &Icon{Name: "emoji-talking-angry", Type: "Outline", Size: "24"}
-	EmojiTalkingHappy = &Icon{Name: "emoji-talking-happy", Type: "Outline", Size: "24"}
-	EmojiThinkLeft = &Icon{Name: "emoji-think-left", Type: "Outline", Size: "24"}
-	EmojiThinkRight = &Icon{Name: "emoji-think-right", Type: "Outline", Size: "24"}
-	EmptyPage = &Icon{Name: "empty-page", Type: "Outline", Size: "24"}
-	EnergyUsageWindow = &Icon{Name: "energy-usage-window", Type: "Outline", Size: "24"}
-	Enlarge = &Icon{Name: "enlarge", Type: "Outline", Size: "24"}
-	Erase = &Icon{Name: "erase", Type: "Outline", Size: "24"}
-	EraseSolid = &Icon{Name: "erase-solid", Type: "Solid", Size: "24"}
-	EthereumCircle = &Icon{Name: "ethereum-circle", Type: "Outline", Size: "24"}
-	EthereumCircleSolid = &Icon{Name: "ethereum-circle-solid", Type: "Solid", Size: "24"}
-	EthereumRotateOut = &Icon{Name: "ethereum-rotate-out", Type: "Outline", Size: "24"}
-	Euro = &Icon{Name: "euro", Type: "Outline", Size: "24"}
-	EuroSquare = &Icon{Name: "euro-square", Type: "Outline", Size: "24"}
-	EuroSquareSolid = &Icon{Name: "euro-square-solid", Type: "Solid", Size: "24"}
-	EvCharge = &Icon{Name: "ev-charge", Type: "Outline", Size: "24"}
-	EvChargeAlt = &Icon{Name: "ev-charge-alt", Type: "Outline", Size: "24"}
-	EvPlug = &Icon{Name: "ev-plug", Type: "Outline", Size: "24"}
-	EvPlugCharging = &Icon{Name: "ev-plug-charging", Type: "Outline", Size: "24"}
-	EvPlugXmark = &Icon{Name: "ev-plug-xmark", Type: "Outline", Size: "24"}
-	EvStation = &Icon{Name: "ev-station", Type: "Outline", Size: "24"}
-	EvTag = &Icon{Name: "ev-tag", Type: "Outline", Size: "24"}
-	Exclude = &Icon{Name: "exclude", Type: "Outline", Size: "24"}
-	Expand = &Icon{Name: "expand", Type: "Outline", Size: "24"}
-	ExpandLines = &Icon{Name: "expand-lines", Type: "Outline", Size: "24"}
-	Extrude = &Icon{Name: "extrude", Type: "Outline", Size: "24"}
-	Eye = &Icon{Name: "eye", Type: "Outline", Size: "24"}
-	EyeClosed = &Icon{Name: "eye-closed", Type: "Outline", Size: "24"}
-	EyeEmpty = &Icon{Name: "eye-empty", Type: "Outline", Size: "24"}
-	EyeOff = &Icon{Name: "eye-off", Type: "Outline", Size: "24"}
-	EyeSolid = &Icon{Name: "eye-solid", Type: "Solid", Size: "24"}
-	FSquare = &Icon{Name: "f-square", Type: "Outline", Size: "24"}
-	Face3dDraft = &Icon{Name: "face-3d-draft", Type: "Outline", Size: "24"}
-	FaceId = &Icon{Name: "face-id", Type: "Outline", Size: "24"}
-	Facebook = &Icon{Name: "facebook", Type: "Outline", Size: "24"}
-	FacebookTag = &Icon{Name: "facebook-tag", Type: "Outline", Size: "24"}
-	Facetime = &Icon{Name: "facetime", Type: "Outline", Size: "24"}
-	FacetimeSolid = &Icon{Name: "facetime-solid", Type: "Solid", Size: "24"}
-	Farm = &Icon{Name: "farm", Type: "Outline", Size: "24"}
-	FastArrowDown = &Icon{Name: "fast-arrow-down", Type: "Outline", Size: "24"}
-	FastArrowDownSquare = &Icon{Name: "fast-arrow-down-square", Type: "Outline", Size: "24"}
-	FastArrowLeft = &Icon{Name: "fast-arrow-left", Type: "Outline", Size: "24"}
-	FastArrowLeftSquare = &Icon{Name: "fast-arrow-left-square", Type: "Outline", Size: "24"}
-	FastArrowRight = &Icon{Name: "fast-arrow-right", Type: "Outline", Size: "24"}
-	FastArrowRightSquare = &Icon{Name: "fast-arrow-right-square", Type: "Outline", Size: "24"}
-	FastArrowUp = &Icon{Name: "fast-arrow-up", Type: "Outline", Size: "24"}
-	FastArrowUpSquare = &Icon{Name: "fast-arrow-up-square", Type: "Outline", Size: "24"}
-	FastDownCircle = &Icon{Name: "fast-down-circle", Type: "Outline", Size: "24"}
-	FastLeftCircle = &Icon{Name: "fast-left-circle", Type: "Outline", Size: "24"}
-	FastRightCircle = &Icon{Name: "fast-right-circle", Type: "Outline", Size: "24"}
-	FastUpCircle = &Icon{Name: "fast-up-circle", Type: "Outline", Size: "24"}
-	FavouriteBook = &Icon{Name: "favourite-book", Type: "Outline", Size: "24"}
-	FavouriteWindow = &Icon{Name: "favourite-window", Type: "Outline", Size: "24"}
-	Female = &Icon{Name: "female", Type: "Outline", Size: "24"}
-	Figma = &Icon{Name: "figma", Type: "Outline", Size: "24"}
-	FileNotFound = &Icon{Name: "file-not-found", Type: "Outline", Size: "24"}
-	FillColor = &Icon{Name: "fill-color", Type: "Outline", Size: "24"}
-	FillColorSolid = &Icon{Name: "fill-color-solid", Type: "Solid", Size: "24"}
-	Fillet3d = &Icon{Name: "fillet-3d", Type: "Outline", Size: "24"}
-	Filter = &Icon{Name: "filter", Type: "Outline", Size: "24"}
-	FilterAlt = &Icon{Name: "filter-alt", Type: "Outline", Size: "24"}
-	FilterList = &Icon{Name: "filter-list", Type: "Outline", Size: "24"}
-	FilterListCircle = &Icon{Name: "filter-list-circle", Type: "Outline", Size: "24"}
-	FilterSolid = &Icon{Name: "filter-solid", Type: "Solid", Size: "24"}
-	Finder = &Icon{Name: "finder", Type: "Outline", Size: "24"}
-	Fingerprint = &Icon{Name: "fingerprint", Type: "Outline", Size: "24"}
-	FingerprintCheckCircle = &Icon{Name: "fingerprint-check-circle", Type: "Outline", Size: "24"}
-	FingerprintCircle = &Icon{Name: "fingerprint-circle", Type: "Outline", Size: "24"}
-	FingerprintLockCircle = &Icon{Name: "fingerprint-lock-circle", Type: "Outline", Size: "24"}
-	FingerprintScan = &Icon{Name: "fingerprint-scan", Type: "Outline", Size: "24"}
-	FingerprintSquare = &Icon{Name: "fingerprint-square", Type: "Outline", Size: "24"}
-	FingerprintWindow = &Icon{Name: "fingerprint-window", Type: "Outline", Size: "24"}
-	FingerprintXmarkCircle = &Icon{Name: "fingerprint-xmark-circle", Type: "Outline", Size: "24"}
-	FireFlame = &Icon{Name: "fire-flame", Type: "Outline", Size: "24"}
-	Fish = &Icon{Name: "fish", Type: "Outline", Size: "24"}
-	Fishing = &Icon{Name: "fishing", Type: "Outline", Size: "24"}
-	Flare = &Icon{Name: "flare", Type: "Outline", Size: "24"}
-	Flash = &Icon{Name: "flash", Type: "Outline", Size: "24"}
-	FlashOff = &Icon{Name: "flash-off", Type: "Outline", Size: "24"}
-	FlashSolid = &Icon{Name: "flash-solid", Type: "Solid", Size: "24"}
-	Flask = &Icon{Name: "flask", Type: "Outline", Size: "24"}
-	FlaskSolid = &Icon{Name: "flask-solid", Type: "Solid", Size: "24"}
-	Flip = &Icon{Name: "flip", Type: "Outline", Size: "24"}
-	FlipReverse = &Icon{Name: "flip-reverse", Type: "Outline", Size: "24"}
-	FloppyDisk = &Icon{Name: "floppy-disk", Type: "Outline", Size: "24"}
-	FloppyDiskArrowIn = &Icon{Name: "floppy-disk-arrow-in", Type: "Outline", Size: "24"}
-	FloppyDiskArrowOut = &Icon{Name: "floppy-disk-arrow-out", Type: "Outline", Size: "24"}
-	Flower = &Icon{Name: "flower", Type: "Outline", Size: "24"}
-	Fog = &Icon{Name: "fog", Type: "Outline", Size: "24"}
-	Folder = &Icon{Name: "folder", Type: "Outline", Size: "24"}
-	FolderMinus = &Icon{Name: "folder-minus", Type: "Outline", Size: "24"}
-	FolderPlus = &Icon{Name: "folder-plus", Type: "Outline", Size: "24"}
-	FolderSettings = &Icon{Name: "folder-settings", Type: "Outline", Size: "24"}
-	FolderWarning = &Icon{Name: "folder-warning", Type: "Outline", Size: "24"}
-	FontQuestion = &Icon{Name: "font-question", Type: "Outline", Size: "24"}
-	Football = &Icon{Name: "football", Type: "Outline", Size: "24"}
-	FootballBall = &Icon{Name: "football-ball", Type: "Outline", Size: "24"}
-	Forward = &Icon{Name: "forward", Type: "Outline", Size: "24"}
-	Forward15Seconds = &Icon{Name: "forward-15-seconds", Type: "Outline", Size: "24"}
-	ForwardMessage = &Icon{Name: "forward-message", Type: "Outline", Size: "24"}
-	ForwardSolid = &Icon{Name: "forward-solid", Type: "Solid", Size: "24"}
-	Frame = &Icon{Name: "frame", Type: "Outline", Size: "24"}
-	FrameAlt = &Icon{Name: "frame-alt", Type: "Outline", Size: "24"}
-	FrameAltEmpty = &Icon{Name: "frame-alt-empty", Type: "Outline", Size: "24"}
-	FrameMinusIn = &Icon{Name: "frame-minus-in", Type: "Outline", Size: "24"}
-	FramePlusIn = &Icon{Name: "frame-plus-in", Type: "Outline", Size: "24"}
-	FrameSelect = &Icon{Name: "frame-select", Type: "Outline", Size: "24"}
-	FrameSimple = &Icon{Name: "frame-simple", Type: "Outline", Size: "24"}
-	FrameTool = &Icon{Name: "frame-tool", Type: "Outline", Size: "24"}
-	FrameToolSolid = &Icon{Name: "frame-tool-solid", Type: "Solid", Size: "24"}
-	Fridge = &Icon{Name: "fridge", Type: "Outline", Size: "24"}
-	Fx = &Icon{Name: "fx", Type: "Outline", Size: "24"}
-	FxTag = &Icon{Name: "fx-tag", Type: "Outline", Size: "24"}
-	FxTagSolid = &Icon{Name: "fx-tag-solid", Type: "Solid", Size: "24"}
-	Gamepad = &Icon{Name: "gamepad", Type: "Outline", Size: "24"}
-	Garage = &Icon{Name: "garage", Type: "Outline", Size: "24"}
-	Gas = &Icon{Name: "gas", Type: "Outline", Size: "24"}
-	GasTank = &Icon{Name: "gas-tank", Type: "Outline", Size: "24"}
-	GasTankDroplet = &Icon{Name: "gas-tank-droplet", Type: "Outline", Size: "24"}
-	GifFormat = &Icon{Name: "gif-format", Type: "Outline", Size: "24"}
-	Gift = &Icon{Name: "gift", Type: "Outline", Size: "24"}
-	Git = &Icon{Name: "git", Type: "Outline", Size: "24"}
-	GitBranch = &Icon{Name: "git-branch", Type: "Outline", Size: "24"}
-	GitCherryPickCommit = &Icon{Name: "git-cherry-pick-commit", Type: "Outline", Size: "24"}
-	GitCommit = &Icon{Name: "git-commit", Type: "Outline", Size: "24"}
-	GitCompare = &Icon{Name: "git-compare", Type: "Outline", Size: "24"}
-	GitFork = &Icon{Name: "git-fork", Type: "Outline", Size: "24"}
-	GitMerge = &Icon{Name: "git-merge", Type: "Outline", Size: "24"}
-	GitPullRequest = &Icon{Name: "git-pull-request", Type: "Outline", Size: "24"}
-	GitPullRequestClosed = &Icon{Name: "git-pull-request-closed", Type: "Outline", Size: "24"}
-	GitSolid = &Icon{Name: "git-solid", Type: "Solid", Size: "24"}
-	Github = &Icon{Name: "github", Type: "Outline", Size: "24"}
-	GithubCircle = &Icon{Name: "github-circle", Type: "Outline", Size: "24"}
-	GitlabFull = &Icon{Name: "gitlab-full", Type: "Outline", Size: "24"}
-	GlassEmpty = &Icon{Name: "glass-empty", Type: "Outline", Size: "24"}
-	GlassFragile = &Icon{Name: "glass-fragile", Type: "Outline", Size: "24"}
-	GlassHalf = &Icon{Name: "glass-half", Type: "Outline", Size: "24"}
-	GlassHalfAlt = &Icon{Name: "glass-half-alt", Type: "Outline", Size: "24"}
-	Glasses = &Icon{Name: "glasses", Type: "Outline", Size: "24"}
-	Globe = &Icon{Name: "globe", Type: "Outline", Size: "24"}
-	Golf = &Icon{Name: "golf", Type: "Outline", Size: "24"}
-	Google = &Icon{Name: "google", Type: "Outline", Size: "24"}
-	GoogleCircle = &Icon{Name: "google-circle", Type: "Outline", Size: "24"}
-	GoogleDocs = &Icon{Name: "google-docs", Type: "Outline", Size: "24"}
-	GoogleDrive = &Icon{Name: "google-drive", Type: "Outline", Size: "24"}
-	GoogleDriveCheck = &Icon{Name: "google-drive-check", Type: "Outline", Size: "24"}
-	GoogleDriveSync = &Icon{Name: "google-drive-sync", Type: "Outline", Size: "24"}
-	GoogleDriveWarning = &Icon{Name: "google-drive-warning", Type: "Outline", Size: "24"}
-	GoogleHome = &Icon{Name: "google-home", Type: "Outline", Size: "24"}
-	GoogleOne = &Icon{Name: "google-one", Type: "Outline", Size: "24"}
-	Gps = &Icon{Name: "gps", Type: "Outline", Size: "24"}
-	GraduationCap = &Icon{Name: "graduation-cap", Type: "Outline", Size: "24"}
-	GraduationCapSolid = &Icon{Name: "graduation-cap-solid", Type: "Solid", Size: "24"}
-	GraphDown = &Icon{Name: "graph-down", Type: "Outline", Size: "24"}
-	GraphUp = &Icon{Name: "graph-up", Type: "Outline", Size: "24"}
-	GridMinus = &Icon{Name: "grid-minus", Type: "Outline", Size: "24"}
-	GridPlus = &Icon{Name: "grid-plus", Type: "Outline", Size: "24"}
-	GridXmark = &Icon{Name: "grid-xmark", Type: "Outline", Size: "24"}
-	Group = &Icon{Name: "group", Type: "Outline", Size: "24"}
-	Gym = &Icon{Name: "gym", Type: "Outline", Size: "24"}
-	HSquare = &Icon{Name: "h-square", Type: "Outline", Size: "24"}
-	HalfCookie = &Icon{Name: "half-cookie", Type: "Outline", Size: "24"}
-	HalfMoon = &Icon{Name: "half-moon", Type: "Outline", Size: "24"}
-	Hammer = &Icon{Name: "hammer", Type: "Outline", Size: "24"}
-	HandBrake = &Icon{Name: "hand-brake", Type: "Outline", Size: "24"}
-	HandCard = &Icon{Name: "hand-card", Type: "Outline", Size: "24"}
-	HandCash = &Icon{Name: "hand-cash", Type: "Outline", Size: "24"}
-	HandContactless = &Icon{Name: "hand-contactless", Type: "Outline", Size: "24"}
-	Handbag = &Icon{Name: "handbag", Type: "Outline", Size: "24"}
-	HardDrive = &Icon{Name: "hard-drive", Type: "Outline", Size: "24"}
-	Hashtag = &Icon{Name: "hashtag", Type: "Outline", Size: "24"}
-	Hat = &Icon{Name: "hat", Type: "Outline", Size: "24"}
-	Hd = &Icon{Name: "hd", Type: "Outline", Size: "24"}
-	HdDisplay = &Icon{Name: "hd-display", Type: "Outline", Size: "24"}
-	HdDisplaySolid = &Icon{Name: "hd-display-solid", Type: "Solid", Size: "24"}
-	Hdr = &Icon{Name: "hdr", Type: "Outline", Size: "24"}
-	Headset = &Icon{Name: "headset", Type: "Outline", Size: "24"}
-	HeadsetBolt = &Icon{Name: "headset-bolt", Type: "Outline", Size: "24"}
-	HeadsetBoltSolid = &Icon{Name: "headset-bolt-solid", Type: "Solid", Size: "24"}
-	HeadsetHelp = &Icon{Name: "headset-help", Type: "Outline", Size: "24"}
-	HeadsetSolid = &Icon{Name: "headset-solid", Type: "Solid", Size: "24"}
-	HeadsetWarning = &Icon{Name: "headset-warning", Type: "Outline", Size: "24"}
-	HeadsetWarningSolid = &Icon{Name: "headset-warning-solid", Type: "Solid", Size: "24"}
-	HealthShield = &Icon{Name: "health-shield", Type: "Outline", Size: "24"}
-	Healthcare = &Icon{Name: "healthcare", Type: "Outline", Size: "24"}
-	Heart = &Icon{Name: "heart", Type: "Outline", Size: "24"}
-	HeartArrowDown = &Icon{Name: "heart-arrow-down", Type: "Outline", Size: "24"}
-	HeartSolid = &Icon{Name: "heart-solid", Type: "Solid", Size: "24"}
-	HeatingSquare = &Icon{Name: "heating-square", Type: "Outline", Size: "24"}
-	HeatingSquareSolid = &Icon{Name: "heating-square-solid", Type: "Solid", Size: "24"}
-	HeavyRain = &Icon{Name: "heavy-rain", Type: "Outline", Size: "24"}
-	HelpCircle = &Icon{Name: "help-circle", Type: "Outline", Size: "24"}
-	HelpCircleSolid = &Icon{Name: "help-circle-solid", Type: "Solid", Size: "24"}
-	HelpSquare = &Icon{Name: "help-square", Type: "Outline", Size: "24"}
-	HelpSquareSolid = &Icon{Name: "help-square-solid", Type: "Solid", Size: "24"}
-	Heptagon = &Icon{Name: "heptagon", Type: "Outline", Size: "24"}
-	Hexagon = &Icon{Name: "hexagon", Type: "Outline", Size: "24"}
-	HexagonAlt = &Icon{Name: "hexagon-alt", Type: "Outline", Size: "24"}
-	HexagonDice = &Icon{Name: "hexagon-dice", Type: "Outline", Size: "24"}
-	HexagonPlus = &Icon{Name: "hexagon-plus", Type: "Outline", Size: "24"}
-	HistoricShield = &Icon{Name: "historic-shield", Type: "Outline", Size: "24"}
-	HistoricShieldAlt = &Icon{Name: "historic-shield-alt", Type: "Outline", Size: "24"}
-	Home = &Icon{Name: "home", Type: "Outline", Size: "24"}
-	HomeAlt = &Icon{Name: "home-alt", Type: "Outline", Size: "24"}
-	HomeAltSlim = &Icon{Name: "home-alt-slim", Type: "Outline", Size: "24"}
-	HomeAltSlimHoriz = &Icon{Name: "home-alt-slim-horiz", Type: "Outline", Size: "24"}
-	HomeHospital = &Icon{Name: "home-hospital", Type: "Outline", Size: "24"}
-	HomeSale = &Icon{Name: "home-sale", Type: "Outline", Size: "24"}
-	HomeSecure = &Icon{Name: "home-secure", Type: "Outline", Size: "24"}
-	HomeShield = &Icon{Name: "home-shield", Type: "Outline", Size: "24"}
-	HomeSimple = &Icon{Name: "home-simple", Type: "Outline", Size: "24"}
-	HomeSimpleDoor = &Icon{Name: "home-simple-door", Type: "Outline", Size: "24"}
-	HomeTable = &Icon{Name: "home-table", Type: "Outline", Size: "24"}
-	HomeTemperatureIn = &Icon{Name: "home-temperature-in", Type: "Outline", Size: "24"}
-	HomeTemperatureOut = &Icon{Name: "home-temperature-out", Type: "Outline", Size: "24"}
-	HomeUser = &Icon{Name: "home-user", Type: "Outline", Size: "24"}
-	HorizDistributionLeft = &Icon{Name: "horiz-distribution-left", Type: "Outline", Size: "24"}
-	HorizDistributionLeftSolid = &Icon{Name: "horiz-distribution-left-solid", Type: "Solid", Size: "24"}
-	HorizDistributionRight = &Icon{Name: "horiz-distribution-right", Type: "Outline", Size: "24"}
-	HorizDistributionRightSolid = &Icon{Name: "horiz-distribution-right-solid", Type: "Solid", Size: "24"}
-	HorizontalMerge = &Icon{Name: "horizontal-merge", Type: "Outline", Size: "24"}
-	HorizontalSplit = &Icon{Name: "horizontal-split", Type: "Outline", Size: "24"}
-	Hospital = &Icon{Name: "hospital", Type: "Outline", Size: "24"}
-	HospitalCircle = &Icon{Name: "hospital-circle", Type: "Outline", Size: "24"}
-	HospitalCircleSolid = &Icon{Name: "hospital-circle-solid", Type: "Solid", Size: "24"}
-	HotAirBalloon = &Icon{Name: "hot-air-balloon", Type: "Outline", Size: "24"}
-	Hourglass = &Icon{Name: "hourglass", Type: "Outline", Size: "24"}
-	HouseRooms = &Icon{Name: "house-rooms", Type: "Outline", Size: "24"}
-	Html5 = &Icon{Name: "html5", Type: "Outline", Size: "24"}
-	IceCream = &Icon{Name: "ice-cream", Type: "Outline", Size: "24"}
-	IceCreamSolid = &Icon{Name: "ice-cream-solid", Type: "Solid", Size: "24"}
-	Iconoir = &Icon{Name: "iconoir", Type: "Outline", Size: "24"}
-	Import = &Icon{Name: "import", Type: "Outline", Size: "24"}
-	Inclination = &Icon{Name: "inclination", Type: "Outline", Size: "24"}
-	Industry = &Icon{Name: "industry", Type: "Outline", Size: "24"}
-	Infinite = &Icon{Name: "infinite", Type: "Outline", Size: "24"}
-	InfoCircle = &Icon{Name: "info-circle", Type: "Outline", Size: "24"}
-	InfoCircleSolid = &Icon{Name: "info-circle-solid", Type: "Solid", Size: "24"}
-	InputField = &Icon{Name: "input-field", Type: "Outline", Size: "24"}
-	InputOutput = &Icon{Name: "input-output", Type: "Outline", Size: "24"}
-	InputSearch = &Icon{Name: "input-search", Type: "Outline", Size: "24"}
-	Instagram = &Icon{Name: "instagram", Type: "Outline", Size: "24"}
-	Internet = &Icon{Name: "internet", Type: "Outline", Size: "24"}
-	Intersect = &Icon{Name: "intersect", Type: "Outline", Size: "24"}
-	IntersectAlt = &Icon{Name: "intersect-alt", Type: "Outline", Size: "24"}
-	IosSettings = &Icon{Name: "ios-settings", Type: "Outline", Size: "24"}
-	IpAddressTag = &Icon{Name: "ip-address-tag", Type: "Outline", Size: "24"}
-	IrisScan = &Icon{Name: "iris-scan", Type: "Outline", Size: "24"}
-	Italic = &Icon{Name: "italic", Type: "Outline", Size: "24"}
-	ItalicSquare = &Icon{Name: "italic-square", Type: "Outline", Size: "24"}
-	ItalicSquareSolid = &Icon{Name: "italic-square-solid", Type: "Solid", Size: "24"}
-	Jellyfish = &Icon{Name: "jellyfish", Type: "Outline", Size: "24"}
-	Journal = &Icon{Name: "journal", Type: "Outline", Size: "24"}
-	JournalPage = &Icon{Name: "journal-page", Type: "Outline", Size: "24"}
-	JpegFormat = &Icon{Name: "jpeg-format", Type: "Outline", Size: "24"}
-	JpgFormat = &Icon{Name: "jpg-format", Type: "Outline", Size: "24"}
-	KanbanBoard = &Icon{Name: "kanban-board", Type: "Outline", Size: "24"}
-	Key = &Icon{Name: "key", Type: "Outline", Size: "24"}
-	KeyBack = &Icon{Name: "key-back", Type: "Outline", Size: "24"}
-	KeyCommand = &Icon{Name: "key-command", Type: "Outline", Size: "24"}
-	KeyMinus = &Icon{Name: "key-minus", Type: "Outline", Size: "24"}
-	KeyPlus = &Icon{Name: "key-plus", Type: "Outline", Size: "24"}
-	KeyXmark = &Icon{Name: "key-xmark", Type: "Outline", Size: "24"}
-	Keyframe = &Icon{Name: "keyframe", Type: "Outline", Size: "24"}
-	KeyframeAlignCenter = &Icon{Name: "keyframe-align-center", Type: "Outline", Size: "24"}
-	KeyframeAlignCenterSolid = &Icon{Name: "keyframe-align-center-solid", Type: "Solid", Size: "24"}
-	KeyframeAlignHorizontal = &Icon{Name: "keyframe-align-horizontal", Type: "Outline", Size: "24"}
-	KeyframeAlignHorizontalSolid = &Icon{Name: "keyframe-align-horizontal-solid", Type: "Solid", Size: "24"}
-	KeyframeAlignVertical = &Icon{Name: "keyframe-align-vertical", Type: "Outline", Size: "24"}
-	KeyframeAlignVerticalSolid = &Icon{Name: "keyframe-align-vertical-solid", Type: "Solid", Size: "24"}
-	KeyframeMinus = &Icon{Name: "keyframe-minus", Type: "Outline", Size: "24"}
-	KeyframeMinusIn = &Icon{Name: "keyframe-minus-in", Type: "Outline", Size: "24"}
-	KeyframeMinusInSolid = &Icon{Name: "keyframe-minus-in-solid", Type: "Solid", Size: "24"}
-	KeyframeMinusSolid = &Icon{Name: "keyframe-minus-solid", Type: "Solid", Size: "24"}
-	KeyframePlus = &Icon{Name: "keyframe-plus", Type: "Outline", Size: "24"}
-	KeyframePlusIn = &Icon{Name: "keyframe-plus-in", Type: "Outline", Size: "24"}
-	KeyframePlusInSolid = &Icon{Name: "keyframe-plus-in-solid", Type: "Solid", Size: "24"}
-	KeyframePlusSolid = &Icon{Name: "keyframe-plus-solid", Type: "Solid", Size: "24"}
-	KeyframePosition = &Icon{Name: "keyframe-position", Type: "Outline", Size: "24"}
-	KeyframePositionSolid = &Icon{Name: "keyframe-position-solid", Type: "Solid", Size: "24"}
-	KeyframeSolid = &Icon{Name: "keyframe-solid", Type: "Solid", Size: "24"}
-	Keyframes = &Icon{Name: "keyframes", Type: "Outline", Size: "24"}
-	KeyframesCouple = &Icon{Name: "keyframes-couple", Type: "Outline", Size: "24"}
-	KeyframesCoupleSolid = &Icon{Name: "keyframes-couple-solid", Type: "Solid", Size: "24"}
-	KeyframesMinus = &Icon{Name: "keyframes-minus", Type: "Outline", Size: "24"}
-	KeyframesPlus = &Icon{Name: "keyframes-plus", Type: "Outline", Size: "24"}
-	KeyframesSolid = &Icon{Name: "keyframes-solid", Type: "Solid", Size: "24"}
-	Label = &Icon{Name: "label", Type: "Outline", Size: "24"}
-	LabelSolid = &Icon{Name: "label-solid", Type: "Solid", Size: "24"}
-	Lamp = &Icon{Name: "lamp", Type: "Outline", Size: "24"}
-	Language = &Icon{Name: "language", Type: "Outline", Size: "24"}
-	Laptop = &Icon{Name: "laptop", Type: "Outline", Size: "24"}
-	LaptopCharging = &Icon{Name: "laptop-charging", Type: "Outline", Size: "24"}
-	LaptopDevMode = &Icon{Name: "laptop-dev-mode", Type: "Outline", Size: "24"}
-	LaptopFix = &Icon{Name: "laptop-fix", Type: "Outline", Size: "24"}
-	LaptopWarning = &Icon{Name: "laptop-warning", Type: "Outline", Size: "24"}
-	LayoutLeft = &Icon{Name: "layout-left", Type: "Outline", Size: "24"}
-	LayoutRight = &Icon{Name: "layout-right", Type: "Outline", Size: "24"}
-	Leaderboard = &Icon{Name: "leaderboard", Type: "Outline", Size: "24"}
-	LeaderboardStar = &Icon{Name: "leaderboard-star", Type: "Outline", Size: "24"}
-	Leaf = &Icon{Name: "leaf", Type: "Outline", Size: "24"}
-	Learning = &Icon{Name: "learning", Type: "Outline", Size: "24"}
-	Lens = &Icon{Name: "lens", Type: "Outline", Size: "24"}
-	LensPlus = &Icon{Name: "lens-plus", Type: "Outline", Size: "24"}
-	Lifebelt = &Icon{Name: "lifebelt", Type: "Outline", Size: "24"}
-	LightBulb = &Icon{Name: "light-bulb", Type: "Outline", Size: "24"}
-	LightBulbOff = &Icon{Name: "light-bulb-off", Type: "Outline", Size: "24"}
-	LightBulbOn = &Icon{Name: "light-bulb-on", Type: "Outline", Size: "24"}
-	LineSpace = &Icon{Name: "line-space", Type: "Outline", Size: "24"}
-	Linear = &Icon{Name: "linear", Type: "Outline", Size: "24"}
-	Link = &Icon{Name: "link", Type: "Outline", Size: "24"}
-	LinkSlash = &Icon{Name: "link-slash", Type: "Outline", Size: "24"}
-	LinkXmark = &Icon{Name: "link-xmark", Type: "Outline", Size: "24"}
-	Linkedin = &Icon{Name: "linkedin", Type: "Outline", Size: "24"}
-	Linux = &Icon{Name: "linux", Type: "Outline", Size: "24"}
-	List = &Icon{Name: "list", Type: "Outline", Size: "24"}
-	ListSelect = &Icon{Name: "list-select", Type: "Outline", Size: "24"}
-	LitecoinCircle = &Icon{Name: "litecoin-circle", Type: "Outline", Size: "24"}
-	LitecoinCircleSolid = &Icon{Name: "litecoin-circle-solid", Type: "Solid", Size: "24"}
-	LitecoinRotateOut = &Icon{Name: "litecoin-rotate-out", Type: "Outline", Size: "24"}
-	Lock = &Icon{Name: "lock", Type: "Outline", Size: "24"}
-	LockSlash = &Icon{Name: "lock-slash", Type: "Outline", Size: "24"}
-	LockSquare = &Icon{Name: "lock-square", Type: "Outline", Size: "24"}
-	Loft3d = &Icon{Name: "loft-3d", Type: "Outline", Size: "24"}
-	LogIn = &Icon{Name: "log-in", Type: "Outline", Size: "24"}
-	LogNoAccess = &Icon{Name: "log-no-access", Type: "Outline", Size: "24"}
-	LogOut = &Icon{Name: "log-out", Type: "Outline", Size: "24"}
-	LongArrowDownLeft = &Icon{Name: "long-arrow-down-left", Type: "Outline", Size: "24"}
-	LongArrowDownRight = &Icon{Name: "long-arrow-down-right", Type: "Outline", Size: "24"}
-	LongArrowLeftDown = &Icon{Name: "long-arrow-left-down", Type: "Outline", Size: "24"}
-	LongArrowLeftUp = &Icon{Name: "long-arrow-left-up", Type: "Outline", Size: "24"}
-	LongArrowRightDown = &Icon{Name: "long-arrow-right-down", Type: "Outline", Size: "24"}
-	LongArrowRightUp = &Icon{Name: "long-arrow-right-up", Type: "Outline", Size: "24"}
-	LongArrowRightUp1 = &Icon{Name: "long-arrow-right-up-1", Type: "Outline", Size: "24"}
-	LongArrowUpLeft = &Icon{Name: "long-arrow-up-left", Type: "Outline", Size: "24"}
-	LongArrowUpRight = &Icon{Name: "long-arrow-up-right", Type: "Outline", Size: "24"}
-	LotOfCash = &Icon{Name: "lot-of-cash", Type: "Outline", Size: "24"}
-	Lullaby = &Icon{Name: "lullaby", Type: "Outline", Size: "24"}
-	MacControlKey = &Icon{Name: "mac-control-key", Type: "Outline", Size: "24"}
-	MacDock = &Icon{Name: "mac-dock", Type: "Outline", Size: "24"}
-	MacOptionKey = &Icon{Name: "mac-option-key", Type: "Outline", Size: "24"}
-	MacOsWindow = &Icon{Name: "mac-os-window", Type: "Outline", Size: "24"}
-	MagicWand = &Icon{Name: "magic-wand", Type: "Outline", Size: "24"}
-	Magnet = &Icon{Name: "magnet", Type: "Outline", Size: "24"}
-	MagnetEnergy = &Icon{Name: "magnet-energy", Type: "Outline", Size: "24"}
-	MagnetSolid = &Icon{Name: "magnet-solid", Type: "Solid", Size: "24"}
-	Mail = &Icon{Name: "mail", Type: "Outline", Size: "24"}
-	MailIn = &Icon{Name: "mail-in", Type: "Outline", Size: "24"}
-	MailInSolid = &Icon{Name: "mail-in-solid", Type: "Solid", Size: "24"}
-	MailOpen = &Icon{Name: "mail-open", Type: "Outline", Size: "24"}
-	MailOpenSolid = &Icon{Name: "mail-open-solid", Type: "Solid", Size: "24"}
-	MailOut = &Icon{Name: "mail-out", Type: "Outline", Size: "24"}
-	MailOutSolid = &Icon{Name: "mail-out-solid", Type: "Solid", Size: "24"}
-	MailSolid = &Icon{Name: "mail-solid", Type: "Solid", Size: "24"}
-	Male = &Icon{Name: "male", Type: "Outline", Size: "24"}
-	Map = &Icon{Name: "map", Type: "Outline", Size: "24"}
-	MapPin = &Icon{Name: "map-pin", Type: "Outline", Size: "24"}
-	MapPinMinus = &Icon{Name: "map-pin-minus", Type: "Outline", Size: "24"}
-	MapPinPlus = &Icon{Name: "map-pin-plus", Type: "Outline", Size: "24"}
-	MapPinXmark = &Icon{Name: "map-pin-xmark", Type: "Outline", Size: "24"}
-	MapXmark = &Icon{Name: "map-xmark", Type: "Outline", Size: "24"}
-	MapsArrow = &Icon{Name: "maps-arrow", Type: "Outline", Size: "24"}
-	MapsArrowDiagonal = &Icon{Name: "maps-arrow-diagonal", Type: "Outline", Size: "24"}
-	MapsArrowXmark = &Icon{Name: "maps-arrow-xmark", Type: "Outline", Size: "24"}
-	MapsGoStraight = &Icon{Name: "maps-go-straight", Type: "Outline", Size: "24"}
-	MapsTurnBack = &Icon{Name: "maps-turn-back", Type: "Outline", Size: "24"}
-	MapsTurnLeft = &Icon{Name: "maps-turn-left", Type: "Outline", Size: "24"}
-	MapsTurnRight = &Icon{Name: "maps-turn-right", Type: "Outline", Size: "24"}
-	MaskSquare = &Icon{Name: "mask-square", Type: "Outline", Size: "24"}
-	MastercardCard = &Icon{Name: "mastercard-card", Type: "Outline", Size: "24"}
-	Mastodon = &Icon{Name: "mastodon", Type: "Outline", Size: "24"}
-	MathBook = &Icon{Name: "math-book", Type: "Outline", Size: "24"}
-	Maximize = &Icon{Name: "maximize", Type: "Outline", Size: "24"}
-	Medal = &Icon{Name: "medal", Type: "Outline", Size: "24"}
-	Medal1st = &Icon{Name: "medal-1st", Type: "Outline", Size: "24"}
-	Medal1stSolid = &Icon{Name: "medal-1st-solid", Type: "Solid", Size: "24"}
-	MedalSolid = &Icon{Name: "medal-solid", Type: "Solid", Size: "24"}
-	MediaImage = &Icon{Name: "media-image", Type: "Outline", Size: "24"}
-	MediaImageFolder = &Icon{Name: "media-image-folder", Type: "Outline", Size: "24"}
-	MediaImageList = &Icon{Name: "media-image-list", Type: "Outline", Size: "24"}
-	MediaImagePlus = &Icon{Name: "media-image-plus", Type: "Outline", Size: "24"}
-	MediaImageXmark = &Icon{Name: "media-image-xmark", Type: "Outline", Size: "24"}
-	MediaVideo = &Icon{Name: "media-video", Type: "Outline", Size: "24"}
-	MediaVideoFolder = &Icon{Name: "media-video-folder", Type: "Outline", Size: "24"}
-	MediaVideoList = &Icon{Name: "media-video-list", Type: "Outline", Size: "24"}
-	MediaVideoPlus = &Icon{Name: "media-video-plus", Type: "Outline", Size: "24"}
-	MediaVideoXmark = &Icon{Name: "media-video-xmark", Type: "Outline", Size: "24"}
-	Medium = &Icon{Name: "medium", Type: "Outline", Size: "24"}
-	Megaphone = &Icon{Name: "megaphone", Type: "Outline", Size: "24"}
-	Menu = &Icon{Name: "menu", Type: "Outline", Size: "24"}
-	MenuScale = &Icon{Name: "menu-scale", Type: "Outline", Size: "24"}
-	Message = &Icon{Name: "message", Type: "Outline", Size: "24"}
-	MessageAlert = &Icon{Name: "message-alert", Type: "Outline", Size: "24"}
-	MessageAlertSolid = &Icon{Name: "message-alert-solid", Type: "Solid", Size: "24"}
-	MessageSolid = &Icon{Name: "message-solid", Type: "Solid", Size: "24"}
-	MessageText = &Icon{Name: "message-text", Type: "Outline", Size: "24"}
-	MessageTextSolid = &Icon{Name: "message-text-solid", Type: "Solid", Size: "24"}
-	MeterArrowDownRight = &Icon{Name: "meter-arrow-down-right", Type: "Outline", Size: "24"}
-	Metro = &Icon{Name: "metro", Type: "Outline", Size: "24"}
-	Microphone = &Icon{Name: "microphone", Type: "Outline", Size: "24"}
-	MicrophoneCheck = &Icon{Name: "microphone-check", Type: "Outline", Size: "24"}
-	MicrophoneCheckSolid = &Icon{Name: "microphone-check-solid", Type: "Solid", Size: "24"}
-	MicrophoneMinus = &Icon{Name: "microphone-minus", Type: "Outline", Size: "24"}
-	MicrophoneMinusSolid = &Icon{Name: "microphone-minus-solid", Type: "Solid", Size: "24"}
-	MicrophoneMute = &Icon{Name: "microphone-mute", Type: "Outline", Size: "24"}
-	MicrophoneMuteSolid = &Icon{Name: "microphone-mute-solid", Type: "Solid", Size: "24"}
-	MicrophonePlus = &Icon{Name: "microphone-plus", Type: "Outline", Size: "24"}
-	MicrophonePlusSolid = &Icon{Name: "microphone-plus-solid", Type: "Solid", Size: "24"}
-	MicrophoneSolid = &Icon{Name: "microphone-solid", Type: "Solid", Size: "24"}
-	MicrophoneSpeaking = &Icon{Name: "microphone-speaking", Type: "Outline", Size: "24"}
-	MicrophoneSpeakingSolid = &Icon{Name: "microphone-speaking-solid", Type: "Solid", Size: "24"}
-	MicrophoneWarning = &Icon{Name: "microphone-warning", Type: "Outline", Size: "24"}
-	MicrophoneWarningSolid = &Icon{Name: "microphone-warning-solid", Type: "Solid", Size: "24"}
-	Microscope = &Icon{Name: "microscope", Type: "Outline", Size: "24"}
-	MicroscopeSolid = &Icon{Name: "microscope-solid", Type: "Solid", Size: "24"}
-	Minus = &Icon{Name: "minus", Type: "Outline", Size: "24"}
-	MinusCircle = &Icon{Name: "minus-circle", Type: "Outline", Size: "24"}
-	MinusCircleSolid = &Icon{Name: "minus-circle-solid", Type: "Solid", Size: "24"}
-	MinusHexagon = &Icon{Name: "minus-hexagon", Type: "Outline", Size: "24"}
-	MinusSquare = &Icon{Name: "minus-square", Type: "Outline", Size: "24"}
-	MinusSquareDashed = &Icon{Name: "minus-square-dashed", Type: "Outline", Size: "24"}
-	MinusSquareSolid = &Icon{Name: "minus-square-solid", Type: "Solid", Size: "24"}
-	Mirror = &Icon{Name: "mirror", Type: "Outline", Size: "24"}
-	MobileDevMode = &Icon{Name: "mobile-dev-mode", Type: "Outline", Size: "24"}
-	MobileFingerprint = &Icon{Name: "mobile-fingerprint", Type: "Outline", Size: "24"}
-	MobileVoice = &Icon{Name: "mobile-voice", Type: "Outline", Size: "24"}
-	ModernTv = &Icon{Name: "modern-tv", Type: "Outline", Size: "24"}
-	ModernTv4k = &Icon{Name: "modern-tv-4k", Type: "Outline", Size: "24"}
-	MoneySquare = &Icon{Name: "money-square", Type: "Outline", Size: "24"}
-	MoneySquareSolid = &Icon{Name: "money-square-solid", Type: "Solid", Size: "24"}
-	MoonSat = &Icon{Name: "moon-sat", Type: "Outline", Size: "24"}
-	MoreHoriz = &Icon{Name: "more-horiz", Type: "Outline", Size: "24"}
-	MoreHorizCircle = &Icon{Name: "more-horiz-circle", Type: "Outline", Size: "24"}
-	MoreVert = &Icon{Name: "more-vert", Type: "Outline", Size: "24"}
-	MoreVertCircle = &Icon{Name: "more-vert-circle", Type: "Outline", Size: "24"}
-	Motorcycle = &Icon{Name: "motorcycle", Type: "Outline", Size: "24"}
-	MouseButtonLeft = &Icon{Name: "mouse-button-left", Type: "Outline", Size: "24"}
-	MouseButtonRight = &Icon{Name: "mouse-button-right", Type: "Outline", Size: "24"}
-	MouseScrollWheel = &Icon{Name: "mouse-scroll-wheel", Type: "Outline", Size: "24"}
-	Movie = &Icon{Name: "movie", Type: "Outline", Size: "24"}
-	MpegFormat = &Icon{Name: "mpeg-format", Type: "Outline", Size: "24"}
-	MultiBubble = &Icon{Name: "multi-bubble", Type: "Outline", Size: "24"}
-	MultiBubbleSolid = &Icon{Name: "multi-bubble-solid", Type: "Solid", Size: "24"}
-	MultiMacOsWindow = &Icon{Name: "multi-mac-os-window", Type: "Outline", Size: "24"}
-	MultiWindow = &Icon{Name: "multi-window", Type: "Outline", Size: "24"}
-	MultiplePages = &Icon{Name: "multiple-pages", Type: "Outline", Size: "24"}
-	MultiplePagesEmpty = &Icon{Name: "multiple-pages-empty", Type: "Outline", Size: "24"}
-	MultiplePagesMinus = &Icon{Name: "multiple-pages-minus", Type: "Outline", Size: "24"}
-	MultiplePagesPlus = &Icon{Name: "multiple-pages-plus", Type: "Outline", Size: "24"}
-	MultiplePagesXmark = &Icon{Name: "multiple-pages-xmark", Type: "Outline", Size: "24"}
-	MusicDoubleNote = &Icon{Name: "music-double-note", Type: "Outline", Size: "24"}
-	MusicDoubleNotePlus = &Icon{Name: "music-double-note-plus", Type: "Outline", Size: "24"}
-	MusicNote = &Icon{Name: "music-note", Type: "Outline", Size: "24"}
-	MusicNotePlus = &Icon{Name: "music-note-plus", Type: "Outline", Size: "24"}
-	MusicNotePlusSolid = &Icon{Name: "music-note-plus-solid", Type: "Solid", Size: "24"}
-	MusicNoteSolid = &Icon{Name: "music-note-solid", Type: "Solid", Size: "24"}
-	NSquare = &Icon{Name: "n-square", Type: "Outline", Size: "24"}
-	NavArrowDown = &Icon{Name: "nav-arrow-down", Type: "Outline", Size: "24"}
-	NavArrowLeft = &Icon{Name: "nav-arrow-left", Type: "Outline", Size: "24"}
-	NavArrowRight = &Icon{Name: "nav-arrow-right", Type: "Outline", Size: "24"}
-	NavArrowUp = &Icon{Name: "nav-arrow-up", Type: "Outline", Size: "24"}
-	Navigator = &Icon{Name: "navigator", Type: "Outline", Size: "24"}
-	NavigatorAlt = &Icon{Name: "navigator-alt", Type: "Outline", Size: "24"}
-	Neighbourhood = &Icon{Name: "neighbourhood", Type: "Outline", Size: "24"}
-	Network = &Icon{Name: "network", Type: "Outline", Size: "24"}
-	NetworkLeft = &Icon{Name: "network-left", Type: "Outline", Size: "24"}
-	NetworkLeftSolid = &Icon{Name: "network-left-solid", Type: "Solid", Size: "24"}
-	NetworkReverse = &Icon{Name: "network-reverse", Type: "Outline", Size: "24"}
-	NetworkReverseSolid = &Icon{Name: "network-reverse-solid", Type: "Solid", Size: "24"}
-	NetworkRight = &Icon{Name: "network-right", Type: "Outline", Size: "24"}
-	NetworkRightSolid = &Icon{Name: "network-right-solid", Type: "Solid", Size: "24"}
-	NetworkSolid = &Icon{Name: "network-solid", Type: "Solid", Size: "24"}
-	NewTab = &Icon{Name: "new-tab", Type: "Outline", Size: "24"}
-	NintendoSwitch = &Icon{Name: "nintendo-switch", Type: "Outline", Size: "24"}
-	NoSmokingCircle = &Icon{Name: "no-smoking-circle", Type: "Outline", Size: "24"}
-	NonBinary = &Icon{Name: "non-binary", Type: "Outline", Size: "24"}
-	Notes = &Icon{Name: "notes", Type: "Outline", Size: "24"}
-	Npm = &Icon{Name: "npm", Type: "Outline", Size: "24"}
-	NpmSquare = &Icon{Name: "npm-square", Type: "Outline", Size: "24"}
-	Number0Square = &Icon{Name: "number-0-square", Type: "Outline", Size: "24"}
-	Number0SquareSolid = &Icon{Name: "number-0-square-solid", Type: "Solid", Size: "24"}
-	Number1Square = &Icon{Name: "number-1-square", Type: "Outline", Size: "24"}
-	Number1SquareSolid = &Icon{Name: "number-1-square-solid", Type: "Solid", Size: "24"}
-	Number2Square = &Icon{Name: "number-2-square", Type: "Outline", Size: "24"}
-	Number2SquareSolid = &Icon{Name: "number-2-square-solid", Type: "Solid", Size: "24"}
-	Number3Square = &Icon{Name: "number-3-square", Type: "Outline", Size: "24"}
-	Number3SquareSolid = &Icon{Name: "number-3-square-solid", Type: "Solid", Size: "24"}
-	Number4Square = &Icon{Name: "number-4-square", Type: "Outline", Size: "24"}
-	Number4SquareSolid = &Icon{Name: "number-4-square-solid", Type: "Solid", Size: "24"}
-	Number5Square = &Icon{Name: "number-5-square", Type: "Outline", Size: "24"}
-	Number5SquareSolid = &Icon{Name: "number-5-square-solid", Type: "Solid", Size: "24"}
-	Number6Square = &Icon{Name: "number-6-square", Type: "Outline", Size: "24"}
-	Number6SquareSolid = &Icon{Name: "number-6-square-solid", Type: "Solid", Size: "24"}
-	Number7Square = &Icon{Name: "number-7-square", Type: "Outline", Size: "24"}
-	Number7SquareSolid = &Icon{Name: "number-7-square-solid", Type: "Solid", Size: "24"}
-	Number8Square = &Icon{Name: "number-8-square", Type: "Outline", Size: "24"}
-	Number8SquareSolid = &Icon{Name: "number-8-square-solid", Type: "Solid", Size: "24"}
-	Number9Square = &Icon{Name: "number-9-square", Type: "Outline", Size: "24"}
-	Number9SquareSolid = &Icon{Name: "number-9-square-solid", Type: "Solid", Size: "24"}
-	NumberedListLeft = &Icon{Name: "numbered-list-left", Type: "Outline", Size: "24"}
-	NumberedListRight = &Icon{Name: "numbered-list-right", Type: "Outline", Size: "24"}
-	OSquare = &Icon{Name: "o-square", Type: "Outline", Size: "24"}
-	Octagon = &Icon{Name: "octagon", Type: "Outline", Size: "24"}
-	OffTag = &Icon{Name: "off-tag", Type: "Outline", Size: "24"}
-	OilIndustry = &Icon{Name: "oil-industry", Type: "Outline", Size: "24"}
-	Okrs = &Icon{Name: "okrs", Type: "Outline", Size: "24"}
-	OnTag = &Icon{Name: "on-tag", Type: "Outline", Size: "24"}
-	OneFingerSelectHandGesture = &Icon{Name: "one-finger-select-hand-gesture", Type: "Outline", Size: "24"}
-	OnePointCircle = &Icon{Name: "one-point-circle", Type: "Outline", Size: "24"}
-	OpenBook = &Icon{Name: "open-book", Type: "Outline", Size: "24"}
-	OpenInBrowser = &Icon{Name: "open-in-browser", Type: "Outline", Size: "24"}
-	OpenInWindow = &Icon{Name: "open-in-window", Type: "Outline", Size: "24"}
-	OpenNewWindow = &Icon{Name: "open-new-window", Type: "Outline", Size: "24"}
-	OpenSelectHandGesture = &Icon{Name: "open-select-hand-gesture", Type: "Outline", Size: "24"}
-	OpenVpn = &Icon{Name: "open-vpn", Type: "Outline", Size: "24"}
-	OrangeHalf = &Icon{Name: "orange-half", Type: "Outline", Size: "24"}
-	OrangeSlice = &Icon{Name: "orange-slice", Type: "Outline", Size: "24"}
-	OrangeSliceAlt = &Icon{Name: "orange-slice-alt", Type: "Outline", Size: "24"}
-	OrganicFood = &Icon{Name: "organic-food", Type: "Outline", Size: "24"}
-	OrganicFoodSquare = &Icon{Name: "organic-food-square", Type: "Outline", Size: "24"}
-	OrthogonalView = &Icon{Name: "orthogonal-view", Type: "Outline", Size: "24"}
-	Package = &Icon{Name: "package", Type: "Outline", Size: "24"}
-	PackageLock = &Icon{Name: "package-lock", Type: "Outline", Size: "24"}
-	Packages = &Icon{Name: "packages", Type: "Outline", Size: "24"}
-	Pacman = &Icon{Name: "pacman", Type: "Outline", Size: "24"}
-	Page = &Icon{Name: "page", Type: "Outline", Size: "24"}
-	PageDown = &Icon{Name: "page-down", Type: "Outline", Size: "24"}
-	PageEdit = &Icon{Name: "page-edit", Type: "Outline", Size: "24"}
-	PageFlip = &Icon{Name: "page-flip", Type: "Outline", Size: "24"}
-	PageLeft = &Icon{Name: "page-left", Type: "Outline", Size: "24"}
-	PageMinus = &Icon{Name: "page-minus", Type: "Outline", Size: "24"}
-	PageMinusIn = &Icon{Name: "page-minus-in", Type: "Outline", Size: "24"}
-	PagePlus = &Icon{Name: "page-plus", Type: "Outline", Size: "24"}
-	PagePlusIn = &Icon{Name: "page-plus-in", Type: "Outline", Size: "24"}
-	PageRight = &Icon{Name: "page-right", Type: "Outline", Size: "24"}
-	PageSearch = &Icon{Name: "page-search", Type: "Outline", Size: "24"}
-	PageStar = &Icon{Name: "page-star", Type: "Outline", Size: "24"}
-	PageUp = &Icon{Name: "page-up", Type: "Outline", Size: "24"}
-	Palette = &Icon{Name: "palette", Type: "Outline", Size: "24"}
-	PanoramaEnlarge = &Icon{Name: "panorama-enlarge", Type: "Outline", Size: "24"}
-	PanoramaReduce = &Icon{Name: "panorama-reduce", Type: "Outline", Size: "24"}
-	Pants = &Icon{Name: "pants", Type: "Outline", Size: "24"}
-	PantsPockets = &Icon{Name: "pants-pockets", Type: "Outline", Size: "24"}
-	Parking = &Icon{Name: "parking", Type: "Outline", Size: "24"}
-	PasswordCheck = &Icon{Name: "password-check", Type: "Outline", Size: "24"}
-	PasswordCursor = &Icon{Name: "password-cursor", Type: "Outline", Size: "24"}
-	PasswordXmark = &Icon{Name: "password-xmark", Type: "Outline", Size: "24"}
-	PasteClipboard = &Icon{Name: "paste-clipboard", Type: "Outline", Size: "24"}
-	PathArrow = &Icon{Name: "path-arrow", Type: "Outline", Size: "24"}
-	Pause = &Icon{Name: "pause", Type: "Outline", Size: "24"}
-	PauseSolid = &Icon{Name: "pause-solid", Type: "Solid", Size: "24"}
-	PauseWindow = &Icon{Name: "pause-window", Type: "Outline", Size: "24"}
-	Paypal = &Icon{Name: "paypal", Type: "Outline", Size: "24"}
-	PcCheck = &Icon{Name: "pc-check", Type: "Outline", Size: "24"}
-	PcFirewall = &Icon{Name: "pc-firewall", Type: "Outline", Size: "24"}
-	PcMouse = &Icon{Name: "pc-mouse", Type: "Outline", Size: "24"}
-	PcNoEntry = &Icon{Name: "pc-no-entry", Type: "Outline", Size: "24"}
-	PcWarning = &Icon{Name: "pc-warning", Type: "Outline", Size: "24"}
-	PeaceHand = &Icon{Name: "peace-hand", Type: "Outline", Size: "24"}
-	Peerlist = &Icon{Name: "peerlist", Type: "Outline", Size: "24"}
-	PenConnectBluetooth = &Icon{Name: "pen-connect-bluetooth", Type: "Outline", Size: "24"}
-	PenConnectWifi = &Icon{Name: "pen-connect-wifi", Type: "Outline", Size: "24"}
-	PenTablet = &Icon{Name: "pen-tablet", Type: "Outline", Size: "24"}
-	PenTabletConnectUsb = &Icon{Name: "pen-tablet-connect-usb", Type: "Outline", Size: "24"}
-	PenTabletConnectWifi = &Icon{Name: "pen-tablet-connect-wifi", Type: "Outline", Size: "24"}
-	Pentagon = &Icon{Name: "pentagon", Type: "Outline", Size: "24"}
-	PeopleTag = &Icon{Name: "people-tag", Type: "Outline", Size: "24"}
-	PercentRotateOut = &Icon{Name: "percent-rotate-out", Type: "Outline", Size: "24"}
-	Percentage = &Icon{Name: "percentage", Type: "Outline", Size: "24"}
-	PercentageCircle = &Icon{Name: "percentage-circle", Type: "Outline", Size: "24"}
-	PercentageCircleSolid = &Icon{Name: "percentage-circle-solid", Type: "Solid", Size: "24"}
-	PercentageSquare = &Icon{Name: "percentage-square", Type: "Outline", Size: "24"}
-	PercentageSquareSolid = &Icon{Name: "percentage-square-solid", Type: "Solid", Size: "24"}
-	PerspectiveView = &Icon{Name: "perspective-view", Type: "Outline", Size: "24"}
-	PharmacyCrossCircle = &Icon{Name: "pharmacy-cross-circle", Type: "Outline", Size: "24"}
-	PharmacyCrossTag = &Icon{Name: "pharmacy-cross-tag", Type: "Outline", Size: "24"}
-	Phone = &Icon{Name: "phone", Type: "Outline", Size: "24"}
-	PhoneDisabled = &Icon{Name: "phone-disabled", Type: "Outline", Size: "24"}
-	PhoneIncome = &Icon{Name: "phone-income", Type: "Outline", Size: "24"}
-	PhoneIncomeSolid = &Icon{Name: "phone-income-solid", Type: "Solid", Size: "24"}
-	PhoneMinus = &Icon{Name: "phone-minus", Type: "Outline", Size: "24"}
-	PhoneMinusSolid = &Icon{Name: "phone-minus-solid", Type: "Solid", Size: "24"}
-	PhoneOutcome = &Icon{Name: "phone-outcome", Type: "Outline", Size: "24"}
-	PhoneOutcomeSolid = &Icon{Name: "phone-outcome-solid", Type: "Solid", Size: "24"}
-	PhonePaused = &Icon{Name: "phone-paused", Type: "Outline", Size: "24"}
-	PhonePausedSolid = &Icon{Name: "phone-paused-solid", Type: "Solid", Size: "24"}
-	PhonePlus = &Icon{Name: "phone-plus", Type: "Outline", Size: "24"}
-	PhonePlusSolid = &Icon{Name: "phone-plus-solid", Type: "Solid", Size: "24"}
-	PhoneSolid = &Icon{Name: "phone-solid", Type: "Solid", Size: "24"}
-	PhoneXmark = &Icon{Name: "phone-xmark", Type: "Outline", Size: "24"}
-	PhoneXmarkSolid = &Icon{Name: "phone-xmark-solid", Type: "Solid", Size: "24"}
-	PiggyBank = &Icon{Name: "piggy-bank", Type: "Outline", Size: "24"}
-	Pillow = &Icon{Name: "pillow", Type: "Outline", Size: "24"}
-	Pin = &Icon{Name: "pin", Type: "Outline", Size: "24"}
-	PinSlash = &Icon{Name: "pin-slash", Type: "Outline", Size: "24"}
-	PinSlashSolid = &Icon{Name: "pin-slash-solid", Type: "Solid", Size: "24"}
-	PinSolid = &Icon{Name: "pin-solid", Type: "Solid", Size: "24"}
-	PineTree = &Icon{Name: "pine-tree", Type: "Outline", Size: "24"}
-	Pinterest = &Icon{Name: "pinterest", Type: "Outline", Size: "24"}
-	Pipe3d = &Icon{Name: "pipe-3d", Type: "Outline", Size: "24"}
-	PizzaSlice = &Icon{Name: "pizza-slice", Type: "Outline", Size: "24"}
-	Planet = &Icon{Name: "planet", Type: "Outline", Size: "24"}
-	PlanetAlt = &Icon{Name: "planet-alt", Type: "Outline", Size: "24"}
-	PlanetSat = &Icon{Name: "planet-sat", Type: "Outline", Size: "24"}
-	PlanetSolid = &Icon{Name: "planet-solid", Type: "Solid", Size: "24"}
-	Planimetry = &Icon{Name: "planimetry", Type: "Outline", Size: "24"}
-	Play = &Icon{Name: "play", Type: "Outline", Size: "24"}
-	PlaySolid = &Icon{Name: "play-solid", Type: "Solid", Size: "24"}
-	Playlist = &Icon{Name: "playlist", Type: "Outline", Size: "24"}
-	PlaylistPlay = &Icon{Name: "playlist-play", Type: "Outline", Size: "24"}
-	PlaylistPlus = &Icon{Name: "playlist-plus", Type: "Outline", Size: "24"}
-	PlaystationGamepad = &Icon{Name: "playstation-gamepad", Type: "Outline", Size: "24"}
-	PlugTypeA = &Icon{Name: "plug-type-a", Type: "Outline", Size: "24"}
-	PlugTypeC = &Icon{Name: "plug-type-c", Type: "Outline", Size: "24"}
-	PlugTypeG = &Icon{Name: "plug-type-g", Type: "Outline", Size: "24"}
-	PlugTypeL = &Icon{Name: "plug-type-l", Type: "Outline", Size: "24"}
-	Plus = &Icon{Name: "plus", Type: "Outline", Size: "24"}
-	PlusCircle = &Icon{Name: "plus-circle", Type: "Outline", Size: "24"}
-	PlusCircleSolid = &Icon{Name: "plus-circle-solid", Type: "Solid", Size: "24"}
-	PlusSquare = &Icon{Name: "plus-square", Type: "Outline", Size: "24"}
-	PlusSquareDashed = &Icon{Name: "plus-square-dashed", Type: "Outline", Size: "24"}
-	PlusSquareSolid = &Icon{Name: "plus-square-solid", Type: "Solid", Size: "24"}
-	PngFormat = &Icon{Name: "png-format", Type: "Outline", Size: "24"}
-	Pocket = &Icon{Name: "pocket", Type: "Outline", Size: "24"}
-	Podcast = &Icon{Name: "podcast", Type: "Outline", Size: "24"}
-	PodcastSolid = &Icon{Name: "podcast-solid", Type: "Solid", Size: "24"}
-	Pokeball = &Icon{Name: "pokeball", Type: "Outline", Size: "24"}
-	PolarSh = &Icon{Name: "polar-sh", Type: "Outline", Size: "24"}
-	Position = &Icon{Name: "position", Type: "Outline", Size: "24"}
-	PositionAlign = &Icon{Name: "position-align", Type: "Outline", Size: "24"}
-	Post = &Icon{Name: "post", Type: "Outline", Size: "24"}
-	PostSolid = &Icon{Name: "post-solid", Type: "Solid", Size: "24"}
-	Potion = &Icon{Name: "potion", Type: "Outline", Size: "24"}
-	Pound = &Icon{Name: "pound", Type: "Outline", Size: "24"}
-	PrecisionTool = &Icon{Name: "precision-tool", Type: "Outline", Size: "24"}
-	Presentation = &Icon{Name: "presentation", Type: "Outline", Size: "24"}
-	PresentationSolid = &Icon{Name: "presentation-solid", Type: "Solid", Size: "24"}
-	Printer = &Icon{Name: "printer", Type: "Outline", Size: "24"}
-	PrintingPage = &Icon{Name: "printing-page", Type: "Outline", Size: "24"}
-	PriorityDown = &Icon{Name: "priority-down", Type: "Outline", Size: "24"}
-	PriorityDownSolid = &Icon{Name: "priority-down-solid", Type: "Solid", Size: "24"}
-	PriorityHigh = &Icon{Name: "priority-high", Type: "Outline", Size: "24"}
-	PriorityHighSolid = &Icon{Name: "priority-high-solid", Type: "Solid", Size: "24"}
-	PriorityMedium = &Icon{Name: "priority-medium", Type: "Outline", Size: "24"}
-	PriorityMediumSolid = &Icon{Name: "priority-medium-solid", Type: "Solid", Size: "24"}
-	PriorityUp = &Icon{Name: "priority-up", Type: "Outline", Size: "24"}
-	PriorityUpSolid = &Icon{Name: "priority-up-solid", Type: "Solid", Size: "24"}
-	PrivacyPolicy = &Icon{Name: "privacy-policy", Type: "Outline", Size: "24"}
-	PrivateWifi = &Icon{Name: "private-wifi", Type: "Outline", Size: "24"}
-	ProfileCircle = &Icon{Name: "profile-circle", Type: "Outline", Size: "24"}
-	Prohibition = &Icon{Name: "prohibition", Type: "Outline", Size: "24"}
-	ProjectCurve3d = &Icon{Name: "project-curve-3d", Type: "Outline", Size: "24"}
-	Puzzle = &Icon{Name: "puzzle", Type: "Outline", Size: "24"}
-	QrCode = &Icon{Name: "qr-code", Type: "Outline", Size: "24"}
-	QuestionMark = &Icon{Name: "question-mark", Type: "Outline", Size: "24"}
-	Quote = &Icon{Name: "quote", Type: "Outline", Size: "24"}
-	QuoteMessage = &Icon{Name: "quote-message", Type: "Outline", Size: "24"}
-	QuoteMessageSolid = &Icon{Name: "quote-message-solid", Type: "Solid", Size: "24"}
-	QuoteSolid = &Icon{Name: "quote-solid", Type: "Solid", Size: "24"}
-	Radiation = &Icon{Name: "radiation", Type: "Outline", Size: "24"}
-	RadiationSolid = &Icon{Name: "radiation-solid", Type: "Solid", Size: "24"}
-	Radius = &Icon{Name: "radius", Type: "Outline", Size: "24"}
-	RadiusSolid = &Icon{Name: "radius-solid", Type: "Solid", Size: "24"}
-	Rain = &Icon{Name: "rain", Type: "Outline", Size: "24"}
-	RawFormat = &Icon{Name: "raw-format", Type: "Outline", Size: "24"}
-	ReceiveDollars = &Icon{Name: "receive-dollars", Type: "Outline", Size: "24"}
-	ReceiveEuros = &Icon{Name: "receive-euros", Type: "Outline", Size: "24"}
-	ReceivePounds = &Icon{Name: "receive-pounds", Type: "Outline", Size: "24"}
-	ReceiveYens = &Icon{Name: "receive-yens", Type: "Outline", Size: "24"}
-	Redo = &Icon{Name: "redo", Type: "Outline", Size: "24"}
-	RedoAction = &Icon{Name: "redo-action", Type: "Outline", Size: "24"}
-	RedoCircle = &Icon{Name: "redo-circle", Type: "Outline", Size: "24"}
-	RedoCircleSolid = &Icon{Name: "redo-circle-solid", Type: "Solid", Size: "24"}
-	Reduce = &Icon{Name: "reduce", Type: "Outline", Size: "24"}
-	Refresh = &Icon{Name: "refresh", Type: "Outline", Size: "24"}
-	RefreshCircle = &Icon{Name: "refresh-circle", Type: "Outline", Size: "24"}
-	RefreshCircleSolid = &Icon{Name: "refresh-circle-solid", Type: "Solid", Size: "24"}
-	RefreshDouble = &Icon{Name: "refresh-double", Type: "Outline", Size: "24"}
-	ReloadWindow = &Icon{Name: "reload-window", Type: "Outline", Size: "24"}
-	ReminderHandGesture = &Icon{Name: "reminder-hand-gesture", Type: "Outline", Size: "24"}
-	Repeat = &Icon{Name: "repeat", Type: "Outline", Size: "24"}
-	RepeatOnce = &Icon{Name: "repeat-once", Type: "Outline", Size: "24"}
-	Reply = &Icon{Name: "reply", Type: "Outline", Size: "24"}
-	ReplyToMessage = &Icon{Name: "reply-to-message", Type: "Outline", Size: "24"}
-	ReportColumns = &Icon{Name: "report-columns", Type: "Outline", Size: "24"}
-	Reports = &Icon{Name: "reports", Type: "Outline", Size: "24"}
-	ReportsSolid = &Icon{Name: "reports-solid", Type: "Solid", Size: "24"}
-	Repository = &Icon{Name: "repository", Type: "Outline", Size: "24"}
-	Restart = &Icon{Name: "restart", Type: "Outline", Size: "24"}
-	Rewind = &Icon{Name: "rewind", Type: "Outline", Size: "24"}
-	RewindSolid = &Icon{Name: "rewind-solid", Type: "Solid", Size: "24"}
-	Rhombus = &Icon{Name: "rhombus", Type: "Outline", Size: "24"}
-	RhombusArrowRight = &Icon{Name: "rhombus-arrow-right", Type: "Outline", Size: "24"}
-	RhombusArrowRightSolid = &Icon{Name: "rhombus-arrow-right-solid", Type: "Solid", Size: "24"}
-	Rings = &Icon{Name: "rings", Type: "Outline", Size: "24"}
-	Rocket = &Icon{Name: "rocket", Type: "Outline", Size: "24"}
-	Rook = &Icon{Name: "rook", Type: "Outline", Size: "24"}
-	RotateCameraLeft = &Icon{Name: "rotate-camera-left", Type: "Outline", Size: "24"}
-	RotateCameraRight = &Icon{Name: "rotate-camera-right", Type: "Outline", Size: "24"}
-	RoundFlask = &Icon{Name: "round-flask", Type: "Outline", Size: "24"}
-	RoundFlaskSolid = &Icon{Name: "round-flask-solid", Type: "Solid", Size: "24"}
-	RoundedMirror = &Icon{Name: "rounded-mirror", Type: "Outline", Size: "24"}
-	RssFeed = &Icon{Name: "rss-feed", Type: "Outline", Size: "24"}
-	RssFeedTag = &Icon{Name: "rss-feed-tag", Type: "Outline", Size: "24"}
-	RubikCube = &Icon{Name: "rubik-cube", Type: "Outline", Size: "24"}
-	Ruler = &Icon{Name: "ruler", Type: "Outline", Size: "24"}
-	RulerArrows = &Icon{Name: "ruler-arrows", Type: "Outline", Size: "24"}
-	RulerCombine = &Icon{Name: "ruler-combine", Type: "Outline", Size: "24"}
-	RulerMinus = &Icon{Name: "ruler-minus", Type: "Outline", Size: "24"}
-	RulerPlus = &Icon{Name: "ruler-plus", Type: "Outline", Size: "24"}
-	Running = &Icon{Name: "running", Type: "Outline", Size: "24"}
-	Safari = &Icon{Name: "safari", Type: "Outline", Size: "24"}
-	Safe = &Icon{Name: "safe", Type: "Outline", Size: "24"}
-	SafeArrowLeft = &Icon{Name: "safe-arrow-left", Type: "Outline", Size: "24"}
-	SafeArrowRight = &Icon{Name: "safe-arrow-right", Type: "Outline", Size: "24"}
-	SafeOpen = &Icon{Name: "safe-open", Type: "Outline", Size: "24"}
-	Sandals = &Icon{Name: "sandals", Type: "Outline", Size: "24"}
-	ScaleFrameEnlarge = &Icon{Name: "scale-frame-enlarge", Type: "Outline", Size: "24"}
-	ScaleFrameReduce = &Icon{Name: "scale-frame-reduce", Type: "Outline", Size: "24"}
-	ScanBarcode = &Icon{Name: "scan-barcode", Type: "Outline", Size: "24"}
-	ScanQrCode = &Icon{Name: "scan-qr-code", Type: "Outline", Size: "24"}
-	Scanning = &Icon{Name: "scanning", Type: "Outline", Size: "24"}
-	Scarf = &Icon{Name: "scarf", Type: "Outline", Size: "24"}
-	Scissor = &Icon{Name: "scissor", Type: "Outline", Size: "24"}
-	ScissorAlt = &Icon{Name: "scissor-alt", Type: "Outline", Size: "24"}
-	Screenshot = &Icon{Name: "screenshot", Type: "Outline", Size: "24"}
-	SeaAndSun = &Icon{Name: "sea-and-sun", Type: "Outline", Size: "24"}
-	SeaWaves = &Icon{Name: "sea-waves", Type: "Outline", Size: "24"}
-	Search = &Icon{Name: "search", Type: "Outline", Size: "24"}
-	SearchEngine = &Icon{Name: "search-engine", Type: "Outline", Size: "24"}
-	SearchWindow = &Icon{Name: "search-window", Type: "Outline", Size: "24"}
-	SecureWindow = &Icon{Name: "secure-window", Type: "Outline", Size: "24"}
-	SecurityPass = &Icon{Name: "security-pass", Type: "Outline", Size: "24"}
-	SelectEdge3d = &Icon{Name: "select-edge-3d", Type: "Outline", Size: "24"}
-	SelectFace3d = &Icon{Name: "select-face-3d", Type: "Outline", Size: "24"}
-	SelectPoint3d = &Icon{Name: "select-point-3d", Type: "Outline", Size: "24"}
-	SelectWindow = &Icon{Name: "select-window", Type: "Outline", Size: "24"}
-	SelectiveTool = &Icon{Name: "selective-tool", Type: "Outline", Size: "24"}
-	Send = &Icon{Name: "send", Type: "Outline", Size: "24"}
-	SendDiagonal = &Icon{Name: "send-diagonal", Type: "Outline", Size: "24"}
-	SendDiagonalSolid = &Icon{Name: "send-diagonal-solid", Type: "Solid", Size: "24"}
-	SendDollars = &Icon{Name: "send-dollars", Type: "Outline", Size: "24"}
-	SendEuros = &Icon{Name: "send-euros", Type: "Outline", Size: "24"}
-	SendMail = &Icon{Name: "send-mail", Type: "Outline", Size: "24"}
-	SendMailSolid = &Icon{Name: "send-mail-solid", Type: "Solid", Size: "24"}
-	SendPounds = &Icon{Name: "send-pounds", Type: "Outline", Size: "24"}
-	SendSolid = &Icon{Name: "send-solid", Type: "Solid", Size: "24"}
-	SendYens = &Icon{Name: "send-yens", Type: "Outline", Size: "24"}
-	Server = &Icon{Name: "server", Type: "Outline", Size: "24"}
-	ServerConnection = &Icon{Name: "server-connection", Type: "Outline", Size: "24"}
-	ServerConnectionSolid = &Icon{Name: "server-connection-solid", Type: "Solid", Size: "24"}
-	ServerSolid = &Icon{Name: "server-solid", Type: "Solid", Size: "24"}
-	Settings = &Icon{Name: "settings", Type: "Outline", Size: "24"}
-	SettingsProfiles = &Icon{Name: "settings-profiles", Type: "Outline", Size: "24"}
-	ShareAndroid = &Icon{Name: "share-android", Type: "Outline", Size: "24"}
-	ShareAndroidSolid = &Icon{Name: "share-android-solid", Type: "Solid", Size: "24"}
-	ShareIos = &Icon{Name: "share-ios", Type: "Outline", Size: "24"}
-	Shield = &Icon{Name: "shield", Type: "Outline", Size: "24"}
-	ShieldAlert = &Icon{Name: "shield-alert", Type: "Outline", Size: "24"}
-	ShieldAlt = &Icon{Name: "shield-alt", Type: "Outline", Size: "24"}
-	ShieldBroken = &Icon{Name: "shield-broken", Type: "Outline", Size: "24"}
-	ShieldCheck = &Icon{Name: "shield-check", Type: "Outline", Size: "24"}
-	ShieldDownload = &Icon{Name: "shield-download", Type: "Outline", Size: "24"}
-	ShieldEye = &Icon{Name: "shield-eye", Type: "Outline", Size: "24"}
-	ShieldLoading = &Icon{Name: "shield-loading", Type: "Outline", Size: "24"}
-	ShieldMinus = &Icon{Name: "shield-minus", Type: "Outline", Size: "24"}
-	ShieldPlusIn = &Icon{Name: "shield-plus-in", Type: "Outline", Size: "24"}
-	ShieldQuestion = &Icon{Name: "shield-question", Type: "Outline", Size: "24"}
-	ShieldSearch = &Icon{Name: "shield-search", Type: "Outline", Size: "24"}
-	ShieldUpload = &Icon{Name: "shield-upload", Type: "Outline", Size: "24"}
-	ShieldXmark = &Icon{Name: "shield-xmark", Type: "Outline", Size: "24"}
-	Shirt = &Icon{Name: "shirt", Type: "Outline", Size: "24"}
-	ShirtTankTop = &Icon{Name: "shirt-tank-top", Type: "Outline", Size: "24"}
-	Shop = &Icon{Name: "shop", Type: "Outline", Size: "24"}
-	ShopFourTiles = &Icon{Name: "shop-four-tiles", Type: "Outline", Size: "24"}
-	ShopFourTilesWindow = &Icon{Name: "shop-four-tiles-window", Type: "Outline", Size: "24"}
-	ShopWindow = &Icon{Name: "shop-window", Type: "Outline", Size: "24"}
-	ShoppingBag = &Icon{Name: "shopping-bag", Type: "Outline", Size: "24"}
-	ShoppingBagArrowDown = &Icon{Name: "shopping-bag-arrow-down", Type: "Outline", Size: "24"}
-	ShoppingBagArrowUp = &Icon{Name: "shopping-bag-arrow-up", Type: "Outline", Size: "24"}
-	ShoppingBagCheck = &Icon{Name: "shopping-bag-check", Type: "Outline", Size: "24"}
-	ShoppingBagMinus = &Icon{Name: "shopping-bag-minus", Type: "Outline", Size: "24"}
-	ShoppingBagPlus = &Icon{Name: "shopping-bag-plus", Type: "Outline", Size: "24"}
-	ShoppingBagPocket = &Icon{Name: "shopping-bag-pocket", Type: "Outline", Size: "24"}
-	ShoppingBagWarning = &Icon{Name: "shopping-bag-warning", Type: "Outline", Size: "24"}
-	ShoppingCode = &Icon{Name: "shopping-code", Type: "Outline", Size: "24"}
-	ShoppingCodeCheck = &Icon{Name: "shopping-code-check", Type: "Outline", Size: "24"}
-	ShoppingCodeXmark = &Icon{Name: "shopping-code-xmark", Type: "Outline", Size: "24"}
-	ShortPants = &Icon{Name: "short-pants", Type: "Outline", Size: "24"}
-	ShortPantsPockets = &Icon{Name: "short-pants-pockets", Type: "Outline", Size: "24"}
-	ShortcutSquare = &Icon{Name: "shortcut-square", Type: "Outline", Size: "24"}
-	Shuffle = &Icon{Name: "shuffle", Type: "Outline", Size: "24"}
-	SidebarCollapse = &Icon{Name: "sidebar-collapse", Type: "Outline", Size: "24"}
-	SidebarExpand = &Icon{Name: "sidebar-expand", Type: "Outline", Size: "24"}
-	SigmaFunction = &Icon{Name: "sigma-function", Type: "Outline", Size: "24"}
-	SimpleCart = &Icon{Name: "simple-cart", Type: "Outline", Size: "24"}
-	SineWave = &Icon{Name: "sine-wave", Type: "Outline", Size: "24"}
-	SingleTapGesture = &Icon{Name: "single-tap-gesture", Type: "Outline", Size: "24"}
-	Skateboard = &Icon{Name: "skateboard", Type: "Outline", Size: "24"}
-	Skateboarding = &Icon{Name: "skateboarding", Type: "Outline", Size: "24"}
-	SkipNext = &Icon{Name: "skip-next", Type: "Outline", Size: "24"}
-	SkipNextSolid = &Icon{Name: "skip-next-solid", Type: "Solid", Size: "24"}
-	SkipPrev = &Icon{Name: "skip-prev", Type: "Outline", Size: "24"}
-	SkipPrevSolid = &Icon{Name: "skip-prev-solid", Type: "Solid", Size: "24"}
-	Slash = &Icon{Name: "slash", Type: "Outline", Size: "24"}
-	SlashSquare = &Icon{Name: "slash-square", Type: "Outline", Size: "24"}
-	SleeperChair = &Icon{Name: "sleeper-chair", Type: "Outline", Size: "24"}
-	Slips = &Icon{Name: "slips", Type: "Outline", Size: "24"}
-	SmallLamp = &Icon{Name: "small-lamp", Type: "Outline", Size: "24"}
-	SmallLampAlt = &Icon{Name: "small-lamp-alt", Type: "Outline", Size: "24"}
-	SmartphoneDevice = &Icon{Name: "smartphone-device", Type: "Outline", Size: "24"}
-	Smoking = &Icon{Name: "smoking", Type: "Outline", Size: "24"}
-	Snapchat = &Icon{Name: "snapchat", Type: "Outline", Size: "24"}
-	Snow = &Icon{Name: "snow", Type: "Outline", Size: "24"}
-	SnowFlake = &Icon{Name: "snow-flake", Type: "Outline", Size: "24"}
-	Soap = &Icon{Name: "soap", Type: "Outline", Size: "24"}
-	SoccerBall = &Icon{Name: "soccer-ball", Type: "Outline", Size: "24"}
-	Sofa = &Icon{Name: "sofa", Type: "Outline", Size: "24"}
-	Soil = &Icon{Name: "soil", Type: "Outline", Size: "24"}
-	SoilAlt = &Icon{Name: "soil-alt", Type: "Outline", Size: "24"}
-	Sort = &Icon{Name: "sort", Type: "Outline", Size: "24"}
-	SortDown = &Icon{Name: "sort-down", Type: "Outline", Size: "24"}
-	SortUp = &Icon{Name: "sort-up", Type: "Outline", Size: "24"}
-	SoundHigh = &Icon{Name: "sound-high", Type: "Outline", Size: "24"}
-	SoundHighSolid = &Icon{Name: "sound-high-solid", Type: "Solid", Size: "24"}
-	SoundLow = &Icon{Name: "sound-low", Type: "Outline", Size: "24"}
-	SoundLowSolid = &Icon{Name: "sound-low-solid", Type: "Solid", Size: "24"}
-	SoundMin = &Icon{Name: "sound-min", Type: "Outline", Size: "24"}
-	SoundMinSolid = &Icon{Name: "sound-min-solid", Type: "Solid", Size: "24"}
-	SoundOff = &Icon{Name: "sound-off", Type: "Outline", Size: "24"}
-	SoundOffSolid = &Icon{Name: "sound-off-solid", Type: "Solid", Size: "24"}
-	Spades = &Icon{Name: "spades", Type: "Outline", Size: "24"}
-	Spark = &Icon{Name: "spark", Type: "Outline", Size: "24"}
-	SparkSolid = &Icon{Name: "spark-solid", Type: "Solid", Size: "24"}
-	Sparks = &Icon{Name: "sparks", Type: "Outline", Size: "24"}
-	SparksSolid = &Icon{Name: "sparks-solid", Type: "Solid", Size: "24"}
-	Sphere = &Icon{Name: "sphere", Type: "Outline", Size: "24"}
-	Spiral = &Icon{Name: "spiral", Type: "Outline", Size: "24"}
-	SplitArea = &Icon{Name: "split-area", Type: "Outline", Size: "24"}
-	SplitSquareDashed = &Icon{Name: "split-square-dashed", Type: "Outline", Size: "24"}
-	SpockHandGesture = &Icon{Name: "spock-hand-gesture", Type: "Outline", Size: "24"}
-	Spotify = &Icon{Name: "spotify", Type: "Outline", Size: "24"}
-	Square = &Icon{Name: "square", Type: "Outline", Size: "24"}
-	Square3dCornerToCorner = &Icon{Name: "square-3d-corner-to-corner", Type: "Outline", Size: "24"}
-	Square3dFromCenter = &Icon{Name: "square-3d-from-center", Type: "Outline", Size: "24"}
-	Square3dThreePoints = &Icon{Name: "square-3d-three-points", Type: "Outline", Size: "24"}
-	SquareCursor = &Icon{Name: "square-cursor", Type: "Outline", Size: "24"}
-	SquareCursorSolid = &Icon{Name: "square-cursor-solid", Type: "Solid", Size: "24"}
-	SquareDashed = &Icon{Name: "square-dashed", Type: "Outline", Size: "24"}
-	SquareWave = &Icon{Name: "square-wave", Type: "Outline", Size: "24"}
-	Stackoverflow = &Icon{Name: "stackoverflow", Type: "Outline", Size: "24"}
-	Star = &Icon{Name: "star", Type: "Outline", Size: "24"}
-	StarDashed = &Icon{Name: "star-dashed", Type: "Outline", Size: "24"}
-	StarHalfDashed = &Icon{Name: "star-half-dashed", Type: "Outline", Size: "24"}
-	StarSolid = &Icon{Name: "star-solid", Type: "Solid", Size: "24"}
-	StatDown = &Icon{Name: "stat-down", Type: "Outline", Size: "24"}
-	StatUp = &Icon{Name: "stat-up", Type: "Outline", Size: "24"}
-	StatsDownSquare = &Icon{Name: "stats-down-square", Type: "Outline", Size: "24"}
-	StatsDownSquareSolid = &Icon{Name: "stats-down-square-solid", Type: "Solid", Size: "24"}
-	StatsReport = &Icon{Name: "stats-report", Type: "Outline", Size: "24"}
-	StatsUpSquare = &Icon{Name: "stats-up-square", Type: "Outline", Size: "24"}
-	StatsUpSquareSolid = &Icon{Name: "stats-up-square-solid", Type: "Solid", Size: "24"}
-	Strategy = &Icon{Name: "strategy", Type: "Outline", Size: "24"}
-	Stretching = &Icon{Name: "stretching", Type: "Outline", Size: "24"}
-	Strikethrough = &Icon{Name: "strikethrough", Type: "Outline", Size: "24"}
-	Stroller = &Icon{Name: "stroller", Type: "Outline", Size: "24"}
-	StyleBorder = &Icon{Name: "style-border", Type: "Outline", Size: "24"}
-	StyleBorderSolid = &Icon{Name: "style-border-solid", Type: "Solid", Size: "24"}
-	SubmitDocument = &Icon{Name: "submit-document", Type: "Outline", Size: "24"}
-	Substract = &Icon{Name: "substract", Type: "Outline", Size: "24"}
-	Suggestion = &Icon{Name: "suggestion", Type: "Outline", Size: "24"}
-	Suitcase = &Icon{Name: "suitcase", Type: "Outline", Size: "24"}
-	SunLight = &Icon{Name: "sun-light", Type: "Outline", Size: "24"}
-	SvgFormat = &Icon{Name: "svg-format", Type: "Outline", Size: "24"}
-	Sweep3d = &Icon{Name: "sweep-3d", Type: "Outline", Size: "24"}
-	Swimming = &Icon{Name: "swimming", Type: "Outline", Size: "24"}
-	SwipeDownGesture = &Icon{Name: "swipe-down-gesture", Type: "Outline", Size: "24"}
-	SwipeLeftGesture = &Icon{Name: "swipe-left-gesture", Type: "Outline", Size: "24"}
-	SwipeRightGesture = &Icon{Name: "swipe-right-gesture", Type: "Outline", Size: "24"}
-	SwipeTwoFingersDownGesture = &Icon{Name: "swipe-two-fingers-down-gesture", Type: "Outline", Size: "24"}
-	SwipeTwoFingersLeftGesture = &Icon{Name: "swipe-two-fingers-left-gesture", Type: "Outline", Size: "24"}
-	SwipeTwoFingersRightGesture = &Icon{Name: "swipe-two-fingers-right-gesture", Type: "Outline", Size: "24"}
-	SwipeTwoFingersUpGesture = &Icon{Name: "swipe-two-fingers-up-gesture", Type: "Outline", Size: "24"}
-	SwipeUpGesture = &Icon{Name: "swipe-up-gesture", Type: "Outline", Size: "24"}
-	SwitchOff = &Icon{Name: "switch-off", Type: "Outline", Size: "24"}
-	SwitchOn = &Icon{Name: "switch-on", Type: "Outline", Size: "24"}
-	SystemRestart = &Icon{Name: "system-restart", Type: "Outline", Size: "24"}
-	SystemShut = &Icon{Name: "system-shut", Type: "Outline", Size: "24"}
-	Table = &Icon{Name: "table", Type: "Outline", Size: "24"}
-	Table2Columns = &Icon{Name: "table-2-columns", Type: "Outline", Size: "24"}
-	TableRows = &Icon{Name: "table-rows", Type: "Outline", Size: "24"}
-	TaskList = &Icon{Name: "task-list", Type: "Outline", Size: "24"}
-	Telegram = &Icon{Name: "telegram", Type: "Outline", Size: "24"}
-	TelegramCircle = &Icon{Name: "telegram-circle", Type: "Outline", Size: "24"}
-	TemperatureDown = &Icon{Name: "temperature-down", Type: "Outline", Size: "24"}
-	TemperatureHigh = &Icon{Name: "temperature-high", Type: "Outline", Size: "24"}
-	TemperatureLow = &Icon{Name: "temperature-low", Type: "Outline", Size: "24"}
-	TemperatureUp = &Icon{Name: "temperature-up", Type: "Outline", Size: "24"}
-	TennisBall = &Icon{Name: "tennis-ball", Type: "Outline", Size: "24"}
-	TennisBallAlt = &Icon{Name: "tennis-ball-alt", Type: "Outline", Size: "24"}
-	Terminal = &Icon{Name: "terminal", Type: "Outline", Size: "24"}
-	TerminalTag = &Icon{Name: "terminal-tag", Type: "Outline", Size: "24"}
-	TestTube = &Icon{Name: "test-tube", Type: "Outline", Size: "24"}
-	TestTubeSolid = &Icon{Name: "test-tube-solid", Type: "Solid", Size: "24"}
-	Text = &Icon{Name: "text", Type: "Outline", Size: "24"}
-	TextArrowsUpDown = &Icon{Name: "text-arrows-up-down", Type: "Outline", Size: "24"}
-	TextBox = &Icon{Name: "text-box", Type: "Outline", Size: "24"}
-	TextMagnifyingGlass = &Icon{Name: "text-magnifying-glass", Type: "Outline", Size: "24"}
-	TextSize = &Icon{Name: "text-size", Type: "Outline", Size: "24"}
-	TextSquare = &Icon{Name: "text-square", Type: "Outline", Size: "24"}
-	TextSquareSolid = &Icon{Name: "text-square-solid", Type: "Solid", Size: "24"}
-	Threads = &Icon{Name: "threads", Type: "Outline", Size: "24"}
-	ThreePointsCircle = &Icon{Name: "three-points-circle", Type: "Outline", Size: "24"}
-	ThreeStars = &Icon{Name: "three-stars", Type: "Outline", Size: "24"}
-	ThreeStarsSolid = &Icon{Name: "three-stars-solid", Type: "Solid", Size: "24"}
-	ThumbsDown = &Icon{Name: "thumbs-down", Type: "Outline", Size: "24"}
-	ThumbsUp = &Icon{Name: "thumbs-up", Type: "Outline", Size: "24"}
-	Thunderstorm = &Icon{Name: "thunderstorm", Type: "Outline", Size: "24"}
-	TifFormat = &Icon{Name: "tif-format", Type: "Outline", Size: "24"}
-	TiffFormat = &Icon{Name: "tiff-format", Type: "Outline", Size: "24"}
-	Tiktok = &Icon{Name: "tiktok", Type: "Outline", Size: "24"}
-	TimeZone = &Icon{Name: "time-zone", Type: "Outline", Size: "24"}
-	Timer = &Icon{Name: "timer", Type: "Outline", Size: "24"}
-	TimerOff = &Icon{Name: "timer-off", Type: "Outline", Size: "24"}
-	TimerSolid = &Icon{Name: "timer-solid", Type: "Solid", Size: "24"}
-	Tools = &Icon{Name: "tools", Type: "Outline", Size: "24"}
-	Tournament = &Icon{Name: "tournament", Type: "Outline", Size: "24"}
-	Tower = &Icon{Name: "tower", Type: "Outline", Size: "24"}
-	TowerCheck = &Icon{Name: "tower-check", Type: "Outline", Size: "24"}
-	TowerNoAccess = &Icon{Name: "tower-no-access", Type: "Outline", Size: "24"}
-	TowerWarning = &Icon{Name: "tower-warning", Type: "Outline", Size: "24"}
-	Trademark = &Icon{Name: "trademark", Type: "Outline", Size: "24"}
-	Train = &Icon{Name: "train", Type: "Outline", Size: "24"}
-	Tram = &Icon{Name: "tram", Type: "Outline", Size: "24"}
-	TransitionDown = &Icon{Name: "transition-down", Type: "Outline", Size: "24"}
-	TransitionDownSolid = &Icon{Name: "transition-down-solid", Type: "Solid", Size: "24"}
-	TransitionLeft = &Icon{Name: "transition-left", Type: "Outline", Size: "24"}
-	TransitionLeftSolid = &Icon{Name: "transition-left-solid", Type: "Solid", Size: "24"}
-	TransitionRight = &Icon{Name: "transition-right", Type: "Outline", Size: "24"}
-	TransitionRightSolid = &Icon{Name: "transition-right-solid", Type: "Solid", Size: "24"}
-	TransitionUp = &Icon{Name: "transition-up", Type: "Outline", Size: "24"}
-	TransitionUpSolid = &Icon{Name: "transition-up-solid", Type: "Solid", Size: "24"}
-	Translate = &Icon{Name: "translate", Type: "Outline", Size: "24"}
-	Trash = &Icon{Name: "trash", Type: "Outline", Size: "24"}
-	TrashSolid = &Icon{Name: "trash-solid", Type: "Solid", Size: "24"}
-	Treadmill = &Icon{Name: "treadmill", Type: "Outline", Size: "24"}
-	Tree = &Icon{Name: "tree", Type: "Outline", Size: "24"}
-	Trekking = &Icon{Name: "trekking", Type: "Outline", Size: "24"}
-	Trello = &Icon{Name: "trello", Type: "Outline", Size: "24"}
-	Triangle = &Icon{Name: "triangle", Type: "Outline", Size: "24"}
-	TriangleFlag = &Icon{Name: "triangle-flag", Type: "Outline", Size: "24"}
-	TriangleFlagCircle = &Icon{Name: "triangle-flag-circle", Type: "Outline", Size: "24"}
-	TriangleFlagTwoStripes = &Icon{Name: "triangle-flag-two-stripes", Type: "Outline", Size: "24"}
-	Trophy = &Icon{Name: "trophy", Type: "Outline", Size: "24"}
-	Truck = &Icon{Name: "truck", Type: "Outline", Size: "24"}
-	TruckGreen = &Icon{Name: "truck-green", Type: "Outline", Size: "24"}
-	TruckLength = &Icon{Name: "truck-length", Type: "Outline", Size: "24"}
-	Tunnel = &Icon{Name: "tunnel", Type: "Outline", Size: "24"}
-	Tv = &Icon{Name: "tv", Type: "Outline", Size: "24"}
-	TvFix = &Icon{Name: "tv-fix", Type: "Outline", Size: "24"}
-	TvWarning = &Icon{Name: "tv-warning", Type: "Outline", Size: "24"}
-	Twitter = &Icon{Name: "twitter", Type: "Outline", Size: "24"}
-	TwoPointsCircle = &Icon{Name: "two-points-circle", Type: "Outline", Size: "24"}
-	TwoSeaterSofa = &Icon{Name: "two-seater-sofa", Type: "Outline", Size: "24"}
-	Type = &Icon{Name: "type", Type: "Outline", Size: "24"}
-	UTurnArrowLeft = &Icon{Name: "u-turn-arrow-left", Type: "Outline", Size: "24"}
-	UTurnArrowRight = &Icon{Name: "u-turn-arrow-right", Type: "Outline", Size: "24"}
-	Umbrella = &Icon{Name: "umbrella", Type: "Outline", Size: "24"}
-	Underline = &Icon{Name: "underline", Type: "Outline", Size: "24"}
-	UnderlineSquare = &Icon{Name: "underline-square", Type: "Outline", Size: "24"}
-	UnderlineSquareSolid = &Icon{Name: "underline-square-solid", Type: "Solid", Size: "24"}
-	Undo = &Icon{Name: "undo", Type: "Outline", Size: "24"}
-	UndoAction = &Icon{Name: "undo-action", Type: "Outline", Size: "24"}
-	UndoCircle = &Icon{Name: "undo-circle", Type: "Outline", Size: "24"}
-	UndoCircleSolid = &Icon{Name: "undo-circle-solid", Type: "Solid", Size: "24"}
-	Union = &Icon{Name: "union", Type: "Outline", Size: "24"}
-	UnionAlt = &Icon{Name: "union-alt", Type: "Outline", Size: "24"}
-	UnionHorizAlt = &Icon{Name: "union-horiz-alt", Type: "Outline", Size: "24"}
-	Unity = &Icon{Name: "unity", Type: "Outline", Size: "24"}
-	Unity5 = &Icon{Name: "unity-5", Type: "Outline", Size: "24"}
-	Unjoin3d = &Icon{Name: "unjoin-3d", Type: "Outline", Size: "24"}
-	Upload = &Icon{Name: "upload", Type: "Outline", Size: "24"}
-	UploadDataWindow = &Icon{Name: "upload-data-window", Type: "Outline", Size: "24"}
-	UploadSquare = &Icon{Name: "upload-square", Type: "Outline", Size: "24"}
-	UploadSquareSolid = &Icon{Name: "upload-square-solid", Type: "Solid", Size: "24"}
-	Usb = &Icon{Name: "usb", Type: "Outline", Size: "24"}
-	UsbSolid = &Icon{Name: "usb-solid", Type: "Solid", Size: "24"}
-	User = &Icon{Name: "user", Type: "Outline", Size: "24"}
-	UserBadgeCheck = &Icon{Name: "user-badge-check", Type: "Outline", Size: "24"}
-	UserBag = &Icon{Name: "user-bag", Type: "Outline", Size: "24"}
-	UserCart = &Icon{Name: "user-cart", Type: "Outline", Size: "24"}
-	UserCircle = &Icon{Name: "user-circle", Type: "Outline", Size: "24"}
-	UserCrown = &Icon{Name: "user-crown", Type: "Outline", Size: "24"}
-	UserLove = &Icon{Name: "user-love", Type: "Outline", Size: "24"}
-	UserPlus = &Icon{Name: "user-plus", Type: "Outline", Size: "24"}
-	UserScan = &Icon{Name: "user-scan", Type: "Outline", Size: "24"}
-	UserSquare = &Icon{Name: "user-square", Type: "Outline", Size: "24"}
-	UserStar = &Icon{Name: "user-star", Type: "Outline", Size: "24"}
-	UserXmark = &Icon{Name: "user-xmark", Type: "Outline", Size: "24"}
-	Vegan = &Icon{Name: "vegan", Type: "Outline", Size: "24"}
-	VeganCircle = &Icon{Name: "vegan-circle", Type: "Outline", Size: "24"}
-	VeganSquare = &Icon{Name: "vegan-square", Type: "Outline", Size: "24"}
-	VehicleGreen = &Icon{Name: "vehicle-green", Type: "Outline", Size: "24"}
-	VerifiedBadge = &Icon{Name: "verified-badge", Type: "Outline", Size: "24"}
-	VerticalMerge = &Icon{Name: "vertical-merge", Type: "Outline", Size: "24"}
-	VerticalSplit = &Icon{Name: "vertical-split", Type: "Outline", Size: "24"}
-	Vials = &Icon{Name: "vials", Type: "Outline", Size: "24"}
-	VialsSolid = &Icon{Name: "vials-solid", Type: "Solid", Size: "24"}
-	VideoCamera = &Icon{Name: "video-camera", Type: "Outline", Size: "24"}
-	VideoCameraOff = &Icon{Name: "video-camera-off", Type: "Outline", Size: "24"}
-	VideoProjector = &Icon{Name: "video-projector", Type: "Outline", Size: "24"}
-	View360 = &Icon{Name: "view-360", Type: "Outline", Size: "24"}
-	ViewColumns2 = &Icon{Name: "view-columns-2", Type: "Outline", Size: "24"}
-	ViewColumns3 = &Icon{Name: "view-columns-3", Type: "Outline", Size: "24"}
-	ViewGrid = &Icon{Name: "view-grid", Type: "Outline", Size: "24"}
-	ViewStructureDown = &Icon{Name: "view-structure-down", Type: "Outline", Size: "24"}
-	ViewStructureUp = &Icon{Name: "view-structure-up", Type: "Outline", Size: "24"}
-	Voice = &Icon{Name: "voice", Type: "Outline", Size: "24"}
-	VoiceCheck = &Icon{Name: "voice-check", Type: "Outline", Size: "24"}
-	VoiceCircle = &Icon{Name: "voice-circle", Type: "Outline", Size: "24"}
-	VoiceLockCircle = &Icon{Name: "voice-lock-circle", Type: "Outline", Size: "24"}
-	VoiceScan = &Icon{Name: "voice-scan", Type: "Outline", Size: "24"}
-	VoiceSquare = &Icon{Name: "voice-square", Type: "Outline", Size: "24"}
-	VoiceXmark = &Icon{Name: "voice-xmark", Type: "Outline", Size: "24"}
-	VrTag = &Icon{Name: "vr-tag", Type: "Outline", Size: "24"}
-	VueJs = &Icon{Name: "vue-js", Type: "Outline", Size: "24"}
-	Waist = &Icon{Name: "waist", Type: "Outline", Size: "24"}
-	Walking = &Icon{Name: "walking", Type: "Outline", Size: "24"}
-	Wallet = &Icon{Name: "wallet", Type: "Outline", Size: "24"}
-	WalletSolid = &Icon{Name: "wallet-solid", Type: "Solid", Size: "24"}
-	WarningCircle = &Icon{Name: "warning-circle", Type: "Outline", Size: "24"}
-	WarningCircleSolid = &Icon{Name: "warning-circle-solid", Type: "Solid", Size: "24"}
-	WarningHexagon = &Icon{Name: "warning-hexagon", Type: "Outline", Size: "24"}
-	WarningSquare = &Icon{Name: "warning-square", Type: "Outline", Size: "24"}
-	WarningSquareSolid = &Icon{Name: "warning-square-solid", Type: "Solid", Size: "24"}
-	WarningTriangle = &Icon{Name: "warning-triangle", Type: "Outline", Size: "24"}
-	WarningTriangleSolid = &Icon{Name: "warning-triangle-solid", Type: "Solid", Size: "24"}
-	WarningWindow = &Icon{Name: "warning-window", Type: "Outline", Size: "24"}
-	Wash = &Icon{Name: "wash", Type: "Outline", Size: "24"}
-	WashingMachine = &Icon{Name: "washing-machine", Type: "Outline", Size: "24"}
-	WateringSoil = &Icon{Name: "watering-soil", Type: "Outline", Size: "24"}
-	WebWindow = &Icon{Name: "web-window", Type: "Outline", Size: "24"}
-	WebWindowEnergyConsumption = &Icon{Name: "web-window-energy-consumption", Type: "Outline", Size: "24"}
-	WebWindowEnergyConsumptionSolid = &Icon{Name: "web-window-energy-consumption-solid", Type: "Solid", Size: "24"}
-	WebWindowSolid = &Icon{Name: "web-window-solid", Type: "Solid", Size: "24"}
-	WebWindowXmark = &Icon{Name: "web-window-xmark", Type: "Outline", Size: "24"}
-	WebWindowXmarkSolid = &Icon{Name: "web-window-xmark-solid", Type: "Solid", Size: "24"}
-	WebpFormat = &Icon{Name: "webp-format", Type: "Outline", Size: "24"}
-	Weight = &Icon{Name: "weight", Type: "Outline", Size: "24"}
-	WeightAlt = &Icon{Name: "weight-alt", Type: "Outline", Size: "24"}
-	WhiteFlag = &Icon{Name: "white-flag", Type: "Outline", Size: "24"}
-	WhiteFlagSolid = &Icon{Name: "white-flag-solid", Type: "Solid", Size: "24"}
-	Wifi = &Icon{Name: "wifi", Type: "Outline", Size: "24"}
-	WifiOff = &Icon{Name: "wifi-off", Type: "Outline", Size: "24"}
-	WifiSignalNone = &Icon{Name: "wifi-signal-none", Type: "Outline", Size: "24"}
-	WifiSignalNoneSolid = &Icon{Name: "wifi-signal-none-solid", Type: "Solid", Size: "24"}
-	WifiTag = &Icon{Name: "wifi-tag", Type: "Outline", Size: "24"}
-	WifiTagSolid = &Icon{Name: "wifi-tag-solid", Type: "Solid", Size: "24"}
-	WifiWarning = &Icon{Name: "wifi-warning", Type: "Outline", Size: "24"}
-	WifiWarningSolid = &Icon{Name: "wifi-warning-solid", Type: "Solid", Size: "24"}
-	WifiXmark = &Icon{Name: "wifi-xmark", Type: "Outline", Size: "24"}
-	Wind = &Icon{Name: "wind", Type: "Outline", Size: "24"}
-	WindowCheck = &Icon{Name: "window-check", Type: "Outline", Size: "24"}
-	WindowLock = &Icon{Name: "window-lock", Type: "Outline", Size: "24"}
-	WindowNoAccess = &Icon{Name: "window-no-access", Type: "Outline", Size: "24"}
-	WindowTabs = &Icon{Name: "window-tabs", Type: "Outline", Size: "24"}
-	WindowTabsSolid = &Icon{Name: "window-tabs-solid", Type: "Solid", Size: "24"}
-	WindowXmark = &Icon{Name: "window-xmark", Type: "Outline", Size: "24"}
-	Windows = &Icon{Name: "windows", Type: "Outline", Size: "24"}
-	Wolf = &Icon{Name: "wolf", Type: "Outline", Size: "24"}
-	WolfSolid = &Icon{Name: "wolf-solid", Type: "Solid", Size: "24"}
-	WrapText = &Icon{Name: "wrap-text", Type: "Outline", Size: "24"}
-	Wrench = &Icon{Name: "wrench", Type: "Outline", Size: "24"}
-	Wristwatch = &Icon{Name: "wristwatch", Type: "Outline", Size: "24"}
-	Www = &Icon{Name: "www", Type: "Outline", Size: "24"}
-	X = &Icon{Name: "x", Type: "Outline", Size: "24"}
-	XSquare = &Icon{Name: "x-square", Type: "Outline", Size: "24"}
-	XboxA = &Icon{Name: "xbox-a", Type: "Outline", Size: "24"}
-	XboxB = &Icon{Name: "xbox-b", Type: "Outline", Size: "24"}
-	XboxX = &Icon{Name: "xbox-x", Type: "Outline", Size: "24"}
-	XboxY = &Icon{Name: "xbox-y", Type: "Outline", Size: "24"}
-	Xmark = &Icon{Name: "xmark", Type: "Outline", Size: "24"}
-	XmarkCircle = &Icon{Name: "xmark-circle", Type: "Outline", Size: "24"}
-	XmarkCircleSolid = &Icon{Name: "xmark-circle-solid", Type: "Solid", Size: "24"}
-	XmarkSquare = &Icon{Name: "xmark-square", Type: "Outline", Size: "24"}
-	XmarkSquareSolid = &Icon{Name: "xmark-square-solid", Type: "Solid", Size: "24"}
-	XrayView = &Icon{Name: "xray-view", Type: "Outline", Size: "24"}
-	YSquare = &Icon{Name: "y-square", Type: "Outline", Size: "24"}
-	Yelp = &Icon{Name: "yelp", Type: "Outline", Size: "24"}
-	Yen = &Icon{Name: "yen", Type: "Outline", Size: "24"}
-	YenSquare = &Icon{Name: "yen-square", Type: "Outline", Size: "24"}
-	YenSquareSolid = &Icon{Name: "yen-square-solid", Type: "Solid", Size: "24"}
-	Yoga = &Icon{Name: "yoga", Type: "Outline", Size: "24"}
-	Youtube = &Icon{Name: "youtube", Type: "Outline", Size: "24"}
-	ZSquare = &Icon{Name: "z-square", Type: "Outline", Size: "24"}
-	ZoomIn = &Icon{Name: "zoom-in", Type: "Outline", Size: "24"}
-	ZoomOut = &Icon{Name: "zoom-out", Type: "Outline", Size: "24"}
+	Accessibility = &Icon{Name: "accessibility", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AccessibilitySign = &Icon{Name: "accessibility-sign", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AccessibilityTech = &Icon{Name: "accessibility-tech", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Activity = &Icon{Name: "activity", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AdobeAfterEffects = &Icon{Name: "adobe-after-effects", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AdobeAfterEffectsSolid = &Icon{Name: "adobe-after-effects-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	AdobeIllustrator = &Icon{Name: "adobe-illustrator", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AdobeIllustratorSolid = &Icon{Name: "adobe-illustrator-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	AdobeIndesign = &Icon{Name: "adobe-indesign", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AdobeIndesignSolid = &Icon{Name: "adobe-indesign-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	AdobeLightroom = &Icon{Name: "adobe-lightroom", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AdobeLightroomSolid = &Icon{Name: "adobe-lightroom-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	AdobePhotoshop = &Icon{Name: "adobe-photoshop", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AdobePhotoshopSolid = &Icon{Name: "adobe-photoshop-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	AdobeXd = &Icon{Name: "adobe-xd", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AdobeXdSolid = &Icon{Name: "adobe-xd-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	AfricanTree = &Icon{Name: "african-tree", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Agile = &Icon{Name: "agile", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AirConditioner = &Icon{Name: "air-conditioner", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Airplane = &Icon{Name: "airplane", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AirplaneHelix = &Icon{Name: "airplane-helix", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AirplaneHelix45deg = &Icon{Name: "airplane-helix-45deg", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AirplaneOff = &Icon{Name: "airplane-off", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AirplaneRotation = &Icon{Name: "airplane-rotation", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Airplay = &Icon{Name: "airplay", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AirplaySolid = &Icon{Name: "airplay-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Alarm = &Icon{Name: "alarm", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AlarmSolid = &Icon{Name: "alarm-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Album = &Icon{Name: "album", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AlbumCarousel = &Icon{Name: "album-carousel", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AlbumList = &Icon{Name: "album-list", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AlbumOpen = &Icon{Name: "album-open", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AlignBottomBox = &Icon{Name: "align-bottom-box", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AlignBottomBoxSolid = &Icon{Name: "align-bottom-box-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	AlignCenter = &Icon{Name: "align-center", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AlignHorizontalCenters = &Icon{Name: "align-horizontal-centers", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AlignHorizontalCentersSolid = &Icon{Name: "align-horizontal-centers-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	AlignHorizontalSpacing = &Icon{Name: "align-horizontal-spacing", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AlignHorizontalSpacingSolid = &Icon{Name: "align-horizontal-spacing-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	AlignJustify = &Icon{Name: "align-justify", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AlignLeft = &Icon{Name: "align-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AlignLeftBox = &Icon{Name: "align-left-box", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AlignLeftBoxSolid = &Icon{Name: "align-left-box-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	AlignRight = &Icon{Name: "align-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AlignRightBox = &Icon{Name: "align-right-box", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AlignRightBoxSolid = &Icon{Name: "align-right-box-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	AlignTopBox = &Icon{Name: "align-top-box", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AlignTopBoxSolid = &Icon{Name: "align-top-box-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	AlignVerticalCenters = &Icon{Name: "align-vertical-centers", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AlignVerticalCentersSolid = &Icon{Name: "align-vertical-centers-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	AlignVerticalSpacing = &Icon{Name: "align-vertical-spacing", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AlignVerticalSpacingSolid = &Icon{Name: "align-vertical-spacing-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	AngleTool = &Icon{Name: "angle-tool", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Antenna = &Icon{Name: "antenna", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AntennaOff = &Icon{Name: "antenna-off", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AntennaSignal = &Icon{Name: "antenna-signal", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AntennaSignalTag = &Icon{Name: "antenna-signal-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AppNotification = &Icon{Name: "app-notification", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AppNotificationSolid = &Icon{Name: "app-notification-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	AppStore = &Icon{Name: "app-store", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AppStoreSolid = &Icon{Name: "app-store-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	AppWindow = &Icon{Name: "app-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Apple = &Icon{Name: "apple", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AppleHalf = &Icon{Name: "apple-half", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AppleHalfAlt = &Icon{Name: "apple-half-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AppleImac21 = &Icon{Name: "apple-imac-2021", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AppleImac21Side = &Icon{Name: "apple-imac-2021-side", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AppleMac = &Icon{Name: "apple-mac", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AppleShortcuts = &Icon{Name: "apple-shortcuts", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AppleShortcutsSolid = &Icon{Name: "apple-shortcuts-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	AppleSwift = &Icon{Name: "apple-swift", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AppleWallet = &Icon{Name: "apple-wallet", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArTag = &Icon{Name: "ar-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Arc3d = &Icon{Name: "arc-3d", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Arc3dCenterPoint = &Icon{Name: "arc-3d-center-point", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Arcade = &Icon{Name: "arcade", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Archery = &Icon{Name: "archery", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArcheryMatch = &Icon{Name: "archery-match", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Archive = &Icon{Name: "archive", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AreaSearch = &Icon{Name: "area-search", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowArchery = &Icon{Name: "arrow-archery", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowDown = &Icon{Name: "arrow-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowDownCircle = &Icon{Name: "arrow-down-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowDownCircleSolid = &Icon{Name: "arrow-down-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ArrowDownLeft = &Icon{Name: "arrow-down-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowDownLeftCircle = &Icon{Name: "arrow-down-left-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowDownLeftCircleSolid = &Icon{Name: "arrow-down-left-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ArrowDownLeftSquare = &Icon{Name: "arrow-down-left-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowDownRight = &Icon{Name: "arrow-down-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowDownRightCircle = &Icon{Name: "arrow-down-right-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowDownRightCircleSolid = &Icon{Name: "arrow-down-right-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ArrowDownRightSquare = &Icon{Name: "arrow-down-right-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowDownRightSquareSolid = &Icon{Name: "arrow-down-right-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ArrowDownTag = &Icon{Name: "arrow-down-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowEmailForward = &Icon{Name: "arrow-email-forward", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowEnlargeTag = &Icon{Name: "arrow-enlarge-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowLeft = &Icon{Name: "arrow-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowLeftCircle = &Icon{Name: "arrow-left-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowLeftCircleSolid = &Icon{Name: "arrow-left-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ArrowLeftTag = &Icon{Name: "arrow-left-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowReduceTag = &Icon{Name: "arrow-reduce-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowRight = &Icon{Name: "arrow-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowRightCircle = &Icon{Name: "arrow-right-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowRightCircleSolid = &Icon{Name: "arrow-right-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ArrowRightTag = &Icon{Name: "arrow-right-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowSeparate = &Icon{Name: "arrow-separate", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowSeparateVertical = &Icon{Name: "arrow-separate-vertical", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowUnion = &Icon{Name: "arrow-union", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowUnionVertical = &Icon{Name: "arrow-union-vertical", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowUp = &Icon{Name: "arrow-up", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowUpCircle = &Icon{Name: "arrow-up-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowUpCircleSolid = &Icon{Name: "arrow-up-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ArrowUpLeft = &Icon{Name: "arrow-up-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowUpLeftCircle = &Icon{Name: "arrow-up-left-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowUpLeftCircleSolid = &Icon{Name: "arrow-up-left-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ArrowUpLeftSquare = &Icon{Name: "arrow-up-left-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowUpLeftSquareSolid = &Icon{Name: "arrow-up-left-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ArrowUpRight = &Icon{Name: "arrow-up-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowUpRightCircle = &Icon{Name: "arrow-up-right-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowUpRightCircleSolid = &Icon{Name: "arrow-up-right-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ArrowUpRightSquare = &Icon{Name: "arrow-up-right-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowUpRightSquareSolid = &Icon{Name: "arrow-up-right-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ArrowUpTag = &Icon{Name: "arrow-up-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ArrowsUpFromLine = &Icon{Name: "arrows-up-from-line", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Asana = &Icon{Name: "asana", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Asterisk = &Icon{Name: "asterisk", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AtSign = &Icon{Name: "at-sign", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AtSignCircle = &Icon{Name: "at-sign-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Atom = &Icon{Name: "atom", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Attachment = &Icon{Name: "attachment", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AugmentedReality = &Icon{Name: "augmented-reality", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AutoFlash = &Icon{Name: "auto-flash", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	AviFormat = &Icon{Name: "avi-format", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Axes = &Icon{Name: "axes", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Backward15Seconds = &Icon{Name: "backward-15-seconds", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BadgeCheck = &Icon{Name: "badge-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Bag = &Icon{Name: "bag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Balcony = &Icon{Name: "balcony", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Bank = &Icon{Name: "bank", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Barcode = &Icon{Name: "barcode", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Basketball = &Icon{Name: "basketball", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BasketballField = &Icon{Name: "basketball-field", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Bathroom = &Icon{Name: "bathroom", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BathroomSolid = &Icon{Name: "bathroom-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Battery25 = &Icon{Name: "battery-25", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Battery50 = &Icon{Name: "battery-50", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Battery75 = &Icon{Name: "battery-75", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BatteryCharging = &Icon{Name: "battery-charging", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BatteryEmpty = &Icon{Name: "battery-empty", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BatteryFull = &Icon{Name: "battery-full", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BatteryIndicator = &Icon{Name: "battery-indicator", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BatterySlash = &Icon{Name: "battery-slash", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BatteryWarning = &Icon{Name: "battery-warning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Bbq = &Icon{Name: "bbq", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BeachBag = &Icon{Name: "beach-bag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BeachBagBig = &Icon{Name: "beach-bag-big", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Bed = &Icon{Name: "bed", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BedReady = &Icon{Name: "bed-ready", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Behance = &Icon{Name: "behance", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BehanceTag = &Icon{Name: "behance-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Bell = &Icon{Name: "bell", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BellNotification = &Icon{Name: "bell-notification", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BellNotificationSolid = &Icon{Name: "bell-notification-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	BellOff = &Icon{Name: "bell-off", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Bicycle = &Icon{Name: "bicycle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Bin = &Icon{Name: "bin", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BinFull = &Icon{Name: "bin-full", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BinHalf = &Icon{Name: "bin-half", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BinMinusIn = &Icon{Name: "bin-minus-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BinPlusIn = &Icon{Name: "bin-plus-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Binocular = &Icon{Name: "binocular", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BirthdayCake = &Icon{Name: "birthday-cake", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Bishop = &Icon{Name: "bishop", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Bitbucket = &Icon{Name: "bitbucket", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BitcoinCircle = &Icon{Name: "bitcoin-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BitcoinCircleSolid = &Icon{Name: "bitcoin-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	BitcoinRotateOut = &Icon{Name: "bitcoin-rotate-out", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Bluetooth = &Icon{Name: "bluetooth", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BluetoothTag = &Icon{Name: "bluetooth-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BluetoothTagSolid = &Icon{Name: "bluetooth-tag-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Bold = &Icon{Name: "bold", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BoldSquare = &Icon{Name: "bold-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BoldSquareSolid = &Icon{Name: "bold-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Bonfire = &Icon{Name: "bonfire", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Book = &Icon{Name: "book", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BookLock = &Icon{Name: "book-lock", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BookSolid = &Icon{Name: "book-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	BookStack = &Icon{Name: "book-stack", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Bookmark = &Icon{Name: "bookmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BookmarkBook = &Icon{Name: "bookmark-book", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BookmarkCircle = &Icon{Name: "bookmark-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BookmarkCircleSolid = &Icon{Name: "bookmark-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	BookmarkSolid = &Icon{Name: "bookmark-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	BorderBl = &Icon{Name: "border-bl", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BorderBottom = &Icon{Name: "border-bottom", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BorderBr = &Icon{Name: "border-br", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BorderInner = &Icon{Name: "border-inner", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BorderLeft = &Icon{Name: "border-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BorderOut = &Icon{Name: "border-out", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BorderRight = &Icon{Name: "border-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BorderTl = &Icon{Name: "border-tl", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BorderTop = &Icon{Name: "border-top", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BorderTr = &Icon{Name: "border-tr", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BounceLeft = &Icon{Name: "bounce-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BounceRight = &Icon{Name: "bounce-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BowlingBall = &Icon{Name: "bowling-ball", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Box = &Icon{Name: "box", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Box3dCenter = &Icon{Name: "box-3d-center", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Box3dPoint = &Icon{Name: "box-3d-point", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Box3dThreePoints = &Icon{Name: "box-3d-three-points", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BoxIso = &Icon{Name: "box-iso", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BoxingGlove = &Icon{Name: "boxing-glove", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Brain = &Icon{Name: "brain", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BrainElectricity = &Icon{Name: "brain-electricity", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BrainResearch = &Icon{Name: "brain-research", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BrainWarning = &Icon{Name: "brain-warning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BreadSlice = &Icon{Name: "bread-slice", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Bridge3d = &Icon{Name: "bridge-3d", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BridgeSurface = &Icon{Name: "bridge-surface", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BrightCrown = &Icon{Name: "bright-crown", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BrightStar = &Icon{Name: "bright-star", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Brightness = &Icon{Name: "brightness", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BrightnessWindow = &Icon{Name: "brightness-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BubbleDownload = &Icon{Name: "bubble-download", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BubbleIncome = &Icon{Name: "bubble-income", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BubbleOutcome = &Icon{Name: "bubble-outcome", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BubbleSearch = &Icon{Name: "bubble-search", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BubbleSearchSolid = &Icon{Name: "bubble-search-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	BubbleStar = &Icon{Name: "bubble-star", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BubbleUpload = &Icon{Name: "bubble-upload", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BubbleWarning = &Icon{Name: "bubble-warning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BubbleXmark = &Icon{Name: "bubble-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BubbleXmarkSolid = &Icon{Name: "bubble-xmark-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Building = &Icon{Name: "building", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Bus = &Icon{Name: "bus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BusGreen = &Icon{Name: "bus-green", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	BusStop = &Icon{Name: "bus-stop", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CSquare = &Icon{Name: "c-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CableTag = &Icon{Name: "cable-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CableTagSolid = &Icon{Name: "cable-tag-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Calculator = &Icon{Name: "calculator", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Calendar = &Icon{Name: "calendar", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CalendarArrowDown = &Icon{Name: "calendar-arrow-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CalendarArrowDownSolid = &Icon{Name: "calendar-arrow-down-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	CalendarArrowUp = &Icon{Name: "calendar-arrow-up", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CalendarArrowUpSolid = &Icon{Name: "calendar-arrow-up-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	CalendarCheck = &Icon{Name: "calendar-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CalendarCheckSolid = &Icon{Name: "calendar-check-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	CalendarMinus = &Icon{Name: "calendar-minus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CalendarMinusSolid = &Icon{Name: "calendar-minus-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	CalendarPlus = &Icon{Name: "calendar-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CalendarPlusSolid = &Icon{Name: "calendar-plus-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	CalendarRotate = &Icon{Name: "calendar-rotate", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CalendarRotateSolid = &Icon{Name: "calendar-rotate-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	CalendarXmark = &Icon{Name: "calendar-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CalendarXmarkSolid = &Icon{Name: "calendar-xmark-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Camera = &Icon{Name: "camera", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CameraSolid = &Icon{Name: "camera-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	CandlestickChart = &Icon{Name: "candlestick-chart", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Car = &Icon{Name: "car", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CardLock = &Icon{Name: "card-lock", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CardNoAccess = &Icon{Name: "card-no-access", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CardReader = &Icon{Name: "card-reader", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CardShield = &Icon{Name: "card-shield", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CardWallet = &Icon{Name: "card-wallet", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Cart = &Icon{Name: "cart", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CartAlt = &Icon{Name: "cart-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CartMinus = &Icon{Name: "cart-minus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CartPlus = &Icon{Name: "cart-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Cash = &Icon{Name: "cash", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CashSolid = &Icon{Name: "cash-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Cell2x2 = &Icon{Name: "cell-2x2", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Cellar = &Icon{Name: "cellar", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CenterAlign = &Icon{Name: "center-align", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CenterAlignSolid = &Icon{Name: "center-align-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ChatBubble = &Icon{Name: "chat-bubble", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ChatBubbleCheck = &Icon{Name: "chat-bubble-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ChatBubbleCheckSolid = &Icon{Name: "chat-bubble-check-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ChatBubbleEmpty = &Icon{Name: "chat-bubble-empty", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ChatBubbleEmptySolid = &Icon{Name: "chat-bubble-empty-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ChatBubbleQuestion = &Icon{Name: "chat-bubble-question", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ChatBubbleQuestionSolid = &Icon{Name: "chat-bubble-question-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ChatBubbleSolid = &Icon{Name: "chat-bubble-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ChatBubbleTranslate = &Icon{Name: "chat-bubble-translate", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ChatBubbleTranslateSolid = &Icon{Name: "chat-bubble-translate-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ChatBubbleWarning = &Icon{Name: "chat-bubble-warning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ChatBubbleWarningSolid = &Icon{Name: "chat-bubble-warning-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ChatBubbleXmark = &Icon{Name: "chat-bubble-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ChatBubbleXmarkSolid = &Icon{Name: "chat-bubble-xmark-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ChatLines = &Icon{Name: "chat-lines", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ChatLinesSolid = &Icon{Name: "chat-lines-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ChatMinusIn = &Icon{Name: "chat-minus-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ChatMinusInSolid = &Icon{Name: "chat-minus-in-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ChatPlusIn = &Icon{Name: "chat-plus-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ChatPlusInSolid = &Icon{Name: "chat-plus-in-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Check = &Icon{Name: "check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CheckCircle = &Icon{Name: "check-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CheckCircleSolid = &Icon{Name: "check-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	CheckSquare = &Icon{Name: "check-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CheckSquareSolid = &Icon{Name: "check-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Chocolate = &Icon{Name: "chocolate", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Chromecast = &Icon{Name: "chromecast", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ChromecastActive = &Icon{Name: "chromecast-active", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Church = &Icon{Name: "church", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ChurchSide = &Icon{Name: "church-side", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CigaretteSlash = &Icon{Name: "cigarette-slash", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CinemaOld = &Icon{Name: "cinema-old", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Circle = &Icon{Name: "circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CircleSpark = &Icon{Name: "circle-spark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	City = &Icon{Name: "city", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ClipboardCheck = &Icon{Name: "clipboard-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Clock = &Icon{Name: "clock", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ClockRotateRight = &Icon{Name: "clock-rotate-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ClockSolid = &Icon{Name: "clock-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ClosedCaptionsTag = &Icon{Name: "closed-captions-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ClosedCaptionsTagSolid = &Icon{Name: "closed-captions-tag-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Closet = &Icon{Name: "closet", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Cloud = &Icon{Name: "cloud", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CloudBookmark = &Icon{Name: "cloud-bookmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CloudCheck = &Icon{Name: "cloud-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CloudDesync = &Icon{Name: "cloud-desync", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CloudDownload = &Icon{Name: "cloud-download", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CloudSquare = &Icon{Name: "cloud-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CloudSquareSolid = &Icon{Name: "cloud-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	CloudSunny = &Icon{Name: "cloud-sunny", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CloudSync = &Icon{Name: "cloud-sync", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CloudUpload = &Icon{Name: "cloud-upload", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CloudXmark = &Icon{Name: "cloud-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Code = &Icon{Name: "code", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CodeBrackets = &Icon{Name: "code-brackets", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CodeBracketsSquare = &Icon{Name: "code-brackets-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Codepen = &Icon{Name: "codepen", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CoffeeCup = &Icon{Name: "coffee-cup", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CoinSlash = &Icon{Name: "coin-slash", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Coins = &Icon{Name: "coins", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CoinsSwap = &Icon{Name: "coins-swap", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CollageFrame = &Icon{Name: "collage-frame", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Collapse = &Icon{Name: "collapse", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ColorFilter = &Icon{Name: "color-filter", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ColorPicker = &Icon{Name: "color-picker", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ColorPickerEmpty = &Icon{Name: "color-picker-empty", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ColorWheel = &Icon{Name: "color-wheel", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Combine = &Icon{Name: "combine", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Commodity = &Icon{Name: "commodity", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Community = &Icon{Name: "community", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CompAlignBottom = &Icon{Name: "comp-align-bottom", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CompAlignBottomSolid = &Icon{Name: "comp-align-bottom-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	CompAlignLeft = &Icon{Name: "comp-align-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CompAlignLeftSolid = &Icon{Name: "comp-align-left-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	CompAlignRight = &Icon{Name: "comp-align-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CompAlignRightSolid = &Icon{Name: "comp-align-right-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	CompAlignTop = &Icon{Name: "comp-align-top", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CompAlignTopSolid = &Icon{Name: "comp-align-top-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	CompactDisc = &Icon{Name: "compact-disc", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Compass = &Icon{Name: "compass", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Component = &Icon{Name: "component", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ComponentSolid = &Icon{Name: "component-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Compress = &Icon{Name: "compress", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CompressLines = &Icon{Name: "compress-lines", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Computer = &Icon{Name: "computer", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ConstrainedSurface = &Icon{Name: "constrained-surface", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Consumable = &Icon{Name: "consumable", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Contactless = &Icon{Name: "contactless", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ControlSlider = &Icon{Name: "control-slider", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Cookie = &Icon{Name: "cookie", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CoolingSquare = &Icon{Name: "cooling-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CoolingSquareSolid = &Icon{Name: "cooling-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Copy = &Icon{Name: "copy", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Copyright = &Icon{Name: "copyright", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CornerBottomLeft = &Icon{Name: "corner-bottom-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CornerBottomRight = &Icon{Name: "corner-bottom-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CornerTopLeft = &Icon{Name: "corner-top-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CornerTopRight = &Icon{Name: "corner-top-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Cpu = &Icon{Name: "cpu", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CpuWarning = &Icon{Name: "cpu-warning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CrackedEgg = &Icon{Name: "cracked-egg", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CreativeCommons = &Icon{Name: "creative-commons", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CreditCard = &Icon{Name: "credit-card", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CreditCard2 = &Icon{Name: "credit-card-2", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CreditCardSlash = &Icon{Name: "credit-card-slash", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CreditCardSolid = &Icon{Name: "credit-card-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	CreditCards = &Icon{Name: "credit-cards", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Crib = &Icon{Name: "crib", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Crop = &Icon{Name: "crop", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CropRotateBl = &Icon{Name: "crop-rotate-bl", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CropRotateBr = &Icon{Name: "crop-rotate-br", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CropRotateTl = &Icon{Name: "crop-rotate-tl", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CropRotateTr = &Icon{Name: "crop-rotate-tr", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Crown = &Icon{Name: "crown", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CrownCircle = &Icon{Name: "crown-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Css3 = &Icon{Name: "css3", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Cube = &Icon{Name: "cube", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CubeBandage = &Icon{Name: "cube-bandage", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CubeCutWithCurve = &Icon{Name: "cube-cut-with-curve", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CubeDots = &Icon{Name: "cube-dots", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CubeDotsSolid = &Icon{Name: "cube-dots-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	CubeHole = &Icon{Name: "cube-hole", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CubeReplaceFace = &Icon{Name: "cube-replace-face", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CubeScan = &Icon{Name: "cube-scan", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CubeScanSolid = &Icon{Name: "cube-scan-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	CursorPointer = &Icon{Name: "cursor-pointer", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CurveArray = &Icon{Name: "curve-array", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Cut = &Icon{Name: "cut", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	CutAlt = &Icon{Name: "cut-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Cutlery = &Icon{Name: "cutlery", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Cycling = &Icon{Name: "cycling", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Cylinder = &Icon{Name: "cylinder", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DashFlag = &Icon{Name: "dash-flag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Dashboard = &Icon{Name: "dashboard", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DashboardDots = &Icon{Name: "dashboard-dots", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DashboardSpeed = &Icon{Name: "dashboard-speed", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DataTransferBoth = &Icon{Name: "data-transfer-both", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DataTransferCheck = &Icon{Name: "data-transfer-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DataTransferDown = &Icon{Name: "data-transfer-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DataTransferUp = &Icon{Name: "data-transfer-up", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DataTransferWarning = &Icon{Name: "data-transfer-warning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Database = &Icon{Name: "database", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DatabaseBackup = &Icon{Name: "database-backup", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DatabaseCheck = &Icon{Name: "database-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DatabaseCheckSolid = &Icon{Name: "database-check-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	DatabaseExport = &Icon{Name: "database-export", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DatabaseMonitor = &Icon{Name: "database-monitor", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DatabaseRestore = &Icon{Name: "database-restore", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DatabaseScript = &Icon{Name: "database-script", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DatabaseScriptMinus = &Icon{Name: "database-script-minus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DatabaseScriptPlus = &Icon{Name: "database-script-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DatabaseSearch = &Icon{Name: "database-search", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DatabaseSettings = &Icon{Name: "database-settings", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DatabaseSolid = &Icon{Name: "database-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	DatabaseStar = &Icon{Name: "database-star", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DatabaseStats = &Icon{Name: "database-stats", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DatabaseTag = &Icon{Name: "database-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DatabaseTagSolid = &Icon{Name: "database-tag-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	DatabaseWarning = &Icon{Name: "database-warning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DatabaseXmark = &Icon{Name: "database-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DatabaseXmarkSolid = &Icon{Name: "database-xmark-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	DbStar = &Icon{Name: "db-star", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DeCompress = &Icon{Name: "de-compress", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Delivery = &Icon{Name: "delivery", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DeliveryTruck = &Icon{Name: "delivery-truck", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Depth = &Icon{Name: "depth", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DesignNib = &Icon{Name: "design-nib", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DesignNibSolid = &Icon{Name: "design-nib-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	DesignPencil = &Icon{Name: "design-pencil", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Desk = &Icon{Name: "desk", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Developer = &Icon{Name: "developer", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DewPoint = &Icon{Name: "dew-point", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Dialpad = &Icon{Name: "dialpad", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Diameter = &Icon{Name: "diameter", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DiameterSolid = &Icon{Name: "diameter-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	DiceFive = &Icon{Name: "dice-five", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DiceFour = &Icon{Name: "dice-four", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DiceOne = &Icon{Name: "dice-one", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DiceSix = &Icon{Name: "dice-six", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DiceThree = &Icon{Name: "dice-three", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DiceTwo = &Icon{Name: "dice-two", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DimmerSwitch = &Icon{Name: "dimmer-switch", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DirectorChair = &Icon{Name: "director-chair", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Discord = &Icon{Name: "discord", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Dishwasher = &Icon{Name: "dishwasher", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Display4k = &Icon{Name: "display-4k", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Divide = &Icon{Name: "divide", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DivideThree = &Icon{Name: "divide-three", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Dna = &Icon{Name: "dna", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Dns = &Icon{Name: "dns", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DocMagnifyingGlass = &Icon{Name: "doc-magnifying-glass", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DocMagnifyingGlassIn = &Icon{Name: "doc-magnifying-glass-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DocStar = &Icon{Name: "doc-star", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DocStarIn = &Icon{Name: "doc-star-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DogecoinCircle = &Icon{Name: "dogecoin-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DogecoinCircleSolid = &Icon{Name: "dogecoin-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	DogecoinRotateOut = &Icon{Name: "dogecoin-rotate-out", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Dollar = &Icon{Name: "dollar", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DollarCircle = &Icon{Name: "dollar-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DollarCircleSolid = &Icon{Name: "dollar-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	DomoticWarning = &Icon{Name: "domotic-warning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Donate = &Icon{Name: "donate", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DotArrowDown = &Icon{Name: "dot-arrow-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DotArrowLeft = &Icon{Name: "dot-arrow-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DotArrowRight = &Icon{Name: "dot-arrow-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DotArrowUp = &Icon{Name: "dot-arrow-up", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DoubleCheck = &Icon{Name: "double-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Download = &Icon{Name: "download", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DownloadCircle = &Icon{Name: "download-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DownloadCircleSolid = &Icon{Name: "download-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	DownloadDataWindow = &Icon{Name: "download-data-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DownloadSquare = &Icon{Name: "download-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DownloadSquareSolid = &Icon{Name: "download-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Drag = &Icon{Name: "drag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DragHandGesture = &Icon{Name: "drag-hand-gesture", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Drawer = &Icon{Name: "drawer", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Dribbble = &Icon{Name: "dribbble", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Drone = &Icon{Name: "drone", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DroneChargeFull = &Icon{Name: "drone-charge-full", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DroneChargeHalf = &Icon{Name: "drone-charge-half", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DroneChargeLow = &Icon{Name: "drone-charge-low", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DroneCheck = &Icon{Name: "drone-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DroneLanding = &Icon{Name: "drone-landing", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DroneRefresh = &Icon{Name: "drone-refresh", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DroneTakeOff = &Icon{Name: "drone-take-off", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DroneXmark = &Icon{Name: "drone-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Droplet = &Icon{Name: "droplet", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DropletCheck = &Icon{Name: "droplet-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DropletHalf = &Icon{Name: "droplet-half", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DropletSnowFlakeIn = &Icon{Name: "droplet-snow-flake-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	DropletSnowFlakeInSolid = &Icon{Name: "droplet-snow-flake-in-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	DropletSolid = &Icon{Name: "droplet-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	EaseCurveControlPoints = &Icon{Name: "ease-curve-control-points", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EaseIn = &Icon{Name: "ease-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EaseInControlPoint = &Icon{Name: "ease-in-control-point", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EaseInOut = &Icon{Name: "ease-in-out", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EaseOut = &Icon{Name: "ease-out", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EaseOutControlPoint = &Icon{Name: "ease-out-control-point", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EcologyBook = &Icon{Name: "ecology-book", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Edit = &Icon{Name: "edit", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EditPencil = &Icon{Name: "edit-pencil", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Egg = &Icon{Name: "egg", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Eject = &Icon{Name: "eject", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ElectronicsChip = &Icon{Name: "electronics-chip", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ElectronicsTransistor = &Icon{Name: "electronics-transistor", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Elevator = &Icon{Name: "elevator", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Ellipse3d = &Icon{Name: "ellipse-3d", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Ellipse3dThreePoints = &Icon{Name: "ellipse-3d-three-points", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Emoji = &Icon{Name: "emoji", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiBall = &Icon{Name: "emoji-ball", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiBlinkLeft = &Icon{Name: "emoji-blink-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiBlinkRight = &Icon{Name: "emoji-blink-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiLookDown = &Icon{Name: "emoji-look-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiLookLeft = &Icon{Name: "emoji-look-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiLookRight = &Icon{Name: "emoji-look-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiLookUp = &Icon{Name: "emoji-look-up", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiPuzzled = &Icon{Name: "emoji-puzzled", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiQuite = &Icon{Name: "emoji-quite", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiReally = &Icon{Name: "emoji-really", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiSad = &Icon{Name: "emoji-sad", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiSatisfied = &Icon{Name: "emoji-satisfied", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiSingLeft = &Icon{Name: "emoji-sing-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiSingLeftNote = &Icon{Name: "emoji-sing-left-note", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiSingRight = &Icon{Name: "emoji-sing-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiSingRightNote = &Icon{Name: "emoji-sing-right-note", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiSurprise = &Icon{Name: "emoji-surprise", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiSurpriseAlt = &Icon{Name: "emoji-surprise-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiTalkingAngry = &Icon{Name: "emoji-talking-angry", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiTalkingHappy = &Icon{Name: "emoji-talking-happy", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiThinkLeft = &Icon{Name: "emoji-think-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmojiThinkRight = &Icon{Name: "emoji-think-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EmptyPage = &Icon{Name: "empty-page", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EnergyUsageWindow = &Icon{Name: "energy-usage-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Enlarge = &Icon{Name: "enlarge", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Erase = &Icon{Name: "erase", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EraseSolid = &Icon{Name: "erase-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	EthereumCircle = &Icon{Name: "ethereum-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EthereumCircleSolid = &Icon{Name: "ethereum-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	EthereumRotateOut = &Icon{Name: "ethereum-rotate-out", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Euro = &Icon{Name: "euro", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EuroSquare = &Icon{Name: "euro-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EuroSquareSolid = &Icon{Name: "euro-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	EvCharge = &Icon{Name: "ev-charge", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EvChargeAlt = &Icon{Name: "ev-charge-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EvPlug = &Icon{Name: "ev-plug", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EvPlugCharging = &Icon{Name: "ev-plug-charging", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EvPlugXmark = &Icon{Name: "ev-plug-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EvStation = &Icon{Name: "ev-station", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EvTag = &Icon{Name: "ev-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Exclude = &Icon{Name: "exclude", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Expand = &Icon{Name: "expand", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ExpandLines = &Icon{Name: "expand-lines", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Extrude = &Icon{Name: "extrude", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Eye = &Icon{Name: "eye", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EyeClosed = &Icon{Name: "eye-closed", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EyeEmpty = &Icon{Name: "eye-empty", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EyeOff = &Icon{Name: "eye-off", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	EyeSolid = &Icon{Name: "eye-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	FSquare = &Icon{Name: "f-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Face3dDraft = &Icon{Name: "face-3d-draft", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FaceId = &Icon{Name: "face-id", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Facebook = &Icon{Name: "facebook", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FacebookTag = &Icon{Name: "facebook-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Facetime = &Icon{Name: "facetime", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FacetimeSolid = &Icon{Name: "facetime-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Farm = &Icon{Name: "farm", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FastArrowDown = &Icon{Name: "fast-arrow-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FastArrowDownSquare = &Icon{Name: "fast-arrow-down-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FastArrowLeft = &Icon{Name: "fast-arrow-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FastArrowLeftSquare = &Icon{Name: "fast-arrow-left-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FastArrowRight = &Icon{Name: "fast-arrow-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FastArrowRightSquare = &Icon{Name: "fast-arrow-right-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FastArrowUp = &Icon{Name: "fast-arrow-up", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FastArrowUpSquare = &Icon{Name: "fast-arrow-up-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FastDownCircle = &Icon{Name: "fast-down-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FastLeftCircle = &Icon{Name: "fast-left-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FastRightCircle = &Icon{Name: "fast-right-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FastUpCircle = &Icon{Name: "fast-up-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FavouriteBook = &Icon{Name: "favourite-book", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FavouriteWindow = &Icon{Name: "favourite-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Female = &Icon{Name: "female", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Figma = &Icon{Name: "figma", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FileNotFound = &Icon{Name: "file-not-found", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FillColor = &Icon{Name: "fill-color", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FillColorSolid = &Icon{Name: "fill-color-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Fillet3d = &Icon{Name: "fillet-3d", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Filter = &Icon{Name: "filter", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FilterAlt = &Icon{Name: "filter-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FilterList = &Icon{Name: "filter-list", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FilterListCircle = &Icon{Name: "filter-list-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FilterSolid = &Icon{Name: "filter-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Finder = &Icon{Name: "finder", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Fingerprint = &Icon{Name: "fingerprint", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FingerprintCheckCircle = &Icon{Name: "fingerprint-check-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FingerprintCircle = &Icon{Name: "fingerprint-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FingerprintLockCircle = &Icon{Name: "fingerprint-lock-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FingerprintScan = &Icon{Name: "fingerprint-scan", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FingerprintSquare = &Icon{Name: "fingerprint-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FingerprintWindow = &Icon{Name: "fingerprint-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FingerprintXmarkCircle = &Icon{Name: "fingerprint-xmark-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FireFlame = &Icon{Name: "fire-flame", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Fish = &Icon{Name: "fish", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Fishing = &Icon{Name: "fishing", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Flare = &Icon{Name: "flare", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Flash = &Icon{Name: "flash", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FlashOff = &Icon{Name: "flash-off", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FlashSolid = &Icon{Name: "flash-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Flask = &Icon{Name: "flask", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FlaskSolid = &Icon{Name: "flask-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Flip = &Icon{Name: "flip", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FlipReverse = &Icon{Name: "flip-reverse", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FloppyDisk = &Icon{Name: "floppy-disk", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FloppyDiskArrowIn = &Icon{Name: "floppy-disk-arrow-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FloppyDiskArrowOut = &Icon{Name: "floppy-disk-arrow-out", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Flower = &Icon{Name: "flower", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Fog = &Icon{Name: "fog", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Folder = &Icon{Name: "folder", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FolderMinus = &Icon{Name: "folder-minus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FolderPlus = &Icon{Name: "folder-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FolderSettings = &Icon{Name: "folder-settings", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FolderWarning = &Icon{Name: "folder-warning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FontQuestion = &Icon{Name: "font-question", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Football = &Icon{Name: "football", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FootballBall = &Icon{Name: "football-ball", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Forward = &Icon{Name: "forward", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Forward15Seconds = &Icon{Name: "forward-15-seconds", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ForwardMessage = &Icon{Name: "forward-message", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ForwardSolid = &Icon{Name: "forward-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Frame = &Icon{Name: "frame", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FrameAlt = &Icon{Name: "frame-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FrameAltEmpty = &Icon{Name: "frame-alt-empty", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FrameMinusIn = &Icon{Name: "frame-minus-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FramePlusIn = &Icon{Name: "frame-plus-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FrameSelect = &Icon{Name: "frame-select", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FrameSimple = &Icon{Name: "frame-simple", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FrameTool = &Icon{Name: "frame-tool", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FrameToolSolid = &Icon{Name: "frame-tool-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Fridge = &Icon{Name: "fridge", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Fx = &Icon{Name: "fx", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FxTag = &Icon{Name: "fx-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	FxTagSolid = &Icon{Name: "fx-tag-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Gamepad = &Icon{Name: "gamepad", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Garage = &Icon{Name: "garage", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Gas = &Icon{Name: "gas", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GasTank = &Icon{Name: "gas-tank", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GasTankDroplet = &Icon{Name: "gas-tank-droplet", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GifFormat = &Icon{Name: "gif-format", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Gift = &Icon{Name: "gift", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Git = &Icon{Name: "git", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GitBranch = &Icon{Name: "git-branch", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GitCherryPickCommit = &Icon{Name: "git-cherry-pick-commit", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GitCommit = &Icon{Name: "git-commit", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GitCompare = &Icon{Name: "git-compare", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GitFork = &Icon{Name: "git-fork", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GitMerge = &Icon{Name: "git-merge", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GitPullRequest = &Icon{Name: "git-pull-request", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GitPullRequestClosed = &Icon{Name: "git-pull-request-closed", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GitSolid = &Icon{Name: "git-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Github = &Icon{Name: "github", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GithubCircle = &Icon{Name: "github-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GitlabFull = &Icon{Name: "gitlab-full", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GlassEmpty = &Icon{Name: "glass-empty", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GlassFragile = &Icon{Name: "glass-fragile", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GlassHalf = &Icon{Name: "glass-half", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GlassHalfAlt = &Icon{Name: "glass-half-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Glasses = &Icon{Name: "glasses", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Globe = &Icon{Name: "globe", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Golf = &Icon{Name: "golf", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Google = &Icon{Name: "google", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GoogleCircle = &Icon{Name: "google-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GoogleDocs = &Icon{Name: "google-docs", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GoogleDrive = &Icon{Name: "google-drive", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GoogleDriveCheck = &Icon{Name: "google-drive-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GoogleDriveSync = &Icon{Name: "google-drive-sync", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GoogleDriveWarning = &Icon{Name: "google-drive-warning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GoogleHome = &Icon{Name: "google-home", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GoogleOne = &Icon{Name: "google-one", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Gps = &Icon{Name: "gps", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GraduationCap = &Icon{Name: "graduation-cap", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GraduationCapSolid = &Icon{Name: "graduation-cap-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	GraphDown = &Icon{Name: "graph-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GraphUp = &Icon{Name: "graph-up", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GridMinus = &Icon{Name: "grid-minus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GridPlus = &Icon{Name: "grid-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	GridXmark = &Icon{Name: "grid-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Group = &Icon{Name: "group", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Gym = &Icon{Name: "gym", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HSquare = &Icon{Name: "h-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HalfCookie = &Icon{Name: "half-cookie", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HalfMoon = &Icon{Name: "half-moon", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Hammer = &Icon{Name: "hammer", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HandBrake = &Icon{Name: "hand-brake", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HandCard = &Icon{Name: "hand-card", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HandCash = &Icon{Name: "hand-cash", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HandContactless = &Icon{Name: "hand-contactless", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Handbag = &Icon{Name: "handbag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HardDrive = &Icon{Name: "hard-drive", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Hashtag = &Icon{Name: "hashtag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Hat = &Icon{Name: "hat", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Hd = &Icon{Name: "hd", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HdDisplay = &Icon{Name: "hd-display", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HdDisplaySolid = &Icon{Name: "hd-display-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Hdr = &Icon{Name: "hdr", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Headset = &Icon{Name: "headset", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HeadsetBolt = &Icon{Name: "headset-bolt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HeadsetBoltSolid = &Icon{Name: "headset-bolt-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	HeadsetHelp = &Icon{Name: "headset-help", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HeadsetSolid = &Icon{Name: "headset-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	HeadsetWarning = &Icon{Name: "headset-warning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HeadsetWarningSolid = &Icon{Name: "headset-warning-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	HealthShield = &Icon{Name: "health-shield", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Healthcare = &Icon{Name: "healthcare", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Heart = &Icon{Name: "heart", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HeartArrowDown = &Icon{Name: "heart-arrow-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HeartSolid = &Icon{Name: "heart-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	HeatingSquare = &Icon{Name: "heating-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HeatingSquareSolid = &Icon{Name: "heating-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	HeavyRain = &Icon{Name: "heavy-rain", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HelpCircle = &Icon{Name: "help-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HelpCircleSolid = &Icon{Name: "help-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	HelpSquare = &Icon{Name: "help-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HelpSquareSolid = &Icon{Name: "help-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Heptagon = &Icon{Name: "heptagon", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Hexagon = &Icon{Name: "hexagon", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HexagonAlt = &Icon{Name: "hexagon-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HexagonDice = &Icon{Name: "hexagon-dice", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HexagonPlus = &Icon{Name: "hexagon-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HistoricShield = &Icon{Name: "historic-shield", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HistoricShieldAlt = &Icon{Name: "historic-shield-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Home = &Icon{Name: "home", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HomeAlt = &Icon{Name: "home-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HomeAltSlim = &Icon{Name: "home-alt-slim", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HomeAltSlimHoriz = &Icon{Name: "home-alt-slim-horiz", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HomeHospital = &Icon{Name: "home-hospital", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HomeSale = &Icon{Name: "home-sale", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HomeSecure = &Icon{Name: "home-secure", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HomeShield = &Icon{Name: "home-shield", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HomeSimple = &Icon{Name: "home-simple", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HomeSimpleDoor = &Icon{Name: "home-simple-door", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HomeTable = &Icon{Name: "home-table", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HomeTemperatureIn = &Icon{Name: "home-temperature-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HomeTemperatureOut = &Icon{Name: "home-temperature-out", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HomeUser = &Icon{Name: "home-user", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HorizDistributionLeft = &Icon{Name: "horiz-distribution-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HorizDistributionLeftSolid = &Icon{Name: "horiz-distribution-left-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	HorizDistributionRight = &Icon{Name: "horiz-distribution-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HorizDistributionRightSolid = &Icon{Name: "horiz-distribution-right-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	HorizontalMerge = &Icon{Name: "horizontal-merge", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HorizontalSplit = &Icon{Name: "horizontal-split", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Hospital = &Icon{Name: "hospital", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HospitalCircle = &Icon{Name: "hospital-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HospitalCircleSolid = &Icon{Name: "hospital-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	HotAirBalloon = &Icon{Name: "hot-air-balloon", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Hourglass = &Icon{Name: "hourglass", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	HouseRooms = &Icon{Name: "house-rooms", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Html5 = &Icon{Name: "html5", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	IceCream = &Icon{Name: "ice-cream", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	IceCreamSolid = &Icon{Name: "ice-cream-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Iconoir = &Icon{Name: "iconoir", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Import = &Icon{Name: "import", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Inclination = &Icon{Name: "inclination", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Industry = &Icon{Name: "industry", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Infinite = &Icon{Name: "infinite", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	InfoCircle = &Icon{Name: "info-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	InfoCircleSolid = &Icon{Name: "info-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	InputField = &Icon{Name: "input-field", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	InputOutput = &Icon{Name: "input-output", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	InputSearch = &Icon{Name: "input-search", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Instagram = &Icon{Name: "instagram", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Internet = &Icon{Name: "internet", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Intersect = &Icon{Name: "intersect", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	IntersectAlt = &Icon{Name: "intersect-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	IosSettings = &Icon{Name: "ios-settings", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	IpAddressTag = &Icon{Name: "ip-address-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	IrisScan = &Icon{Name: "iris-scan", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Italic = &Icon{Name: "italic", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ItalicSquare = &Icon{Name: "italic-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ItalicSquareSolid = &Icon{Name: "italic-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Jellyfish = &Icon{Name: "jellyfish", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Journal = &Icon{Name: "journal", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	JournalPage = &Icon{Name: "journal-page", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	JpegFormat = &Icon{Name: "jpeg-format", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	JpgFormat = &Icon{Name: "jpg-format", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	KanbanBoard = &Icon{Name: "kanban-board", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Key = &Icon{Name: "key", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	KeyBack = &Icon{Name: "key-back", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	KeyCommand = &Icon{Name: "key-command", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	KeyMinus = &Icon{Name: "key-minus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	KeyPlus = &Icon{Name: "key-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	KeyXmark = &Icon{Name: "key-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Keyframe = &Icon{Name: "keyframe", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	KeyframeAlignCenter = &Icon{Name: "keyframe-align-center", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	KeyframeAlignCenterSolid = &Icon{Name: "keyframe-align-center-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	KeyframeAlignHorizontal = &Icon{Name: "keyframe-align-horizontal", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	KeyframeAlignHorizontalSolid = &Icon{Name: "keyframe-align-horizontal-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	KeyframeAlignVertical = &Icon{Name: "keyframe-align-vertical", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	KeyframeAlignVerticalSolid = &Icon{Name: "keyframe-align-vertical-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	KeyframeMinus = &Icon{Name: "keyframe-minus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	KeyframeMinusIn = &Icon{Name: "keyframe-minus-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	KeyframeMinusInSolid = &Icon{Name: "keyframe-minus-in-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	KeyframeMinusSolid = &Icon{Name: "keyframe-minus-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	KeyframePlus = &Icon{Name: "keyframe-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	KeyframePlusIn = &Icon{Name: "keyframe-plus-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	KeyframePlusInSolid = &Icon{Name: "keyframe-plus-in-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	KeyframePlusSolid = &Icon{Name: "keyframe-plus-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	KeyframePosition = &Icon{Name: "keyframe-position", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	KeyframePositionSolid = &Icon{Name: "keyframe-position-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	KeyframeSolid = &Icon{Name: "keyframe-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Keyframes = &Icon{Name: "keyframes", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	KeyframesCouple = &Icon{Name: "keyframes-couple", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	KeyframesCoupleSolid = &Icon{Name: "keyframes-couple-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	KeyframesMinus = &Icon{Name: "keyframes-minus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	KeyframesPlus = &Icon{Name: "keyframes-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	KeyframesSolid = &Icon{Name: "keyframes-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Label = &Icon{Name: "label", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LabelSolid = &Icon{Name: "label-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Lamp = &Icon{Name: "lamp", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Language = &Icon{Name: "language", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Laptop = &Icon{Name: "laptop", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LaptopCharging = &Icon{Name: "laptop-charging", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LaptopDevMode = &Icon{Name: "laptop-dev-mode", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LaptopFix = &Icon{Name: "laptop-fix", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LaptopWarning = &Icon{Name: "laptop-warning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LayoutLeft = &Icon{Name: "layout-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LayoutRight = &Icon{Name: "layout-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Leaderboard = &Icon{Name: "leaderboard", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LeaderboardStar = &Icon{Name: "leaderboard-star", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Leaf = &Icon{Name: "leaf", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Learning = &Icon{Name: "learning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Lens = &Icon{Name: "lens", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LensPlus = &Icon{Name: "lens-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Lifebelt = &Icon{Name: "lifebelt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LightBulb = &Icon{Name: "light-bulb", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LightBulbOff = &Icon{Name: "light-bulb-off", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LightBulbOn = &Icon{Name: "light-bulb-on", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LineSpace = &Icon{Name: "line-space", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Linear = &Icon{Name: "linear", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Link = &Icon{Name: "link", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LinkSlash = &Icon{Name: "link-slash", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LinkXmark = &Icon{Name: "link-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Linkedin = &Icon{Name: "linkedin", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Linux = &Icon{Name: "linux", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	List = &Icon{Name: "list", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ListSelect = &Icon{Name: "list-select", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LitecoinCircle = &Icon{Name: "litecoin-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LitecoinCircleSolid = &Icon{Name: "litecoin-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	LitecoinRotateOut = &Icon{Name: "litecoin-rotate-out", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Lock = &Icon{Name: "lock", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LockSlash = &Icon{Name: "lock-slash", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LockSquare = &Icon{Name: "lock-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Loft3d = &Icon{Name: "loft-3d", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LogIn = &Icon{Name: "log-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LogNoAccess = &Icon{Name: "log-no-access", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LogOut = &Icon{Name: "log-out", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LongArrowDownLeft = &Icon{Name: "long-arrow-down-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LongArrowDownRight = &Icon{Name: "long-arrow-down-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LongArrowLeftDown = &Icon{Name: "long-arrow-left-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LongArrowLeftUp = &Icon{Name: "long-arrow-left-up", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LongArrowRightDown = &Icon{Name: "long-arrow-right-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LongArrowRightUp = &Icon{Name: "long-arrow-right-up", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LongArrowRightUp1 = &Icon{Name: "long-arrow-right-up-1", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LongArrowUpLeft = &Icon{Name: "long-arrow-up-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LongArrowUpRight = &Icon{Name: "long-arrow-up-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	LotOfCash = &Icon{Name: "lot-of-cash", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Lullaby = &Icon{Name: "lullaby", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MacControlKey = &Icon{Name: "mac-control-key", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MacDock = &Icon{Name: "mac-dock", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MacOptionKey = &Icon{Name: "mac-option-key", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MacOsWindow = &Icon{Name: "mac-os-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MagicWand = &Icon{Name: "magic-wand", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Magnet = &Icon{Name: "magnet", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MagnetEnergy = &Icon{Name: "magnet-energy", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MagnetSolid = &Icon{Name: "magnet-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Mail = &Icon{Name: "mail", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MailIn = &Icon{Name: "mail-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MailInSolid = &Icon{Name: "mail-in-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	MailOpen = &Icon{Name: "mail-open", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MailOpenSolid = &Icon{Name: "mail-open-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	MailOut = &Icon{Name: "mail-out", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MailOutSolid = &Icon{Name: "mail-out-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	MailSolid = &Icon{Name: "mail-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Male = &Icon{Name: "male", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Map = &Icon{Name: "map", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MapPin = &Icon{Name: "map-pin", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MapPinMinus = &Icon{Name: "map-pin-minus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MapPinPlus = &Icon{Name: "map-pin-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MapPinXmark = &Icon{Name: "map-pin-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MapXmark = &Icon{Name: "map-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MapsArrow = &Icon{Name: "maps-arrow", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MapsArrowDiagonal = &Icon{Name: "maps-arrow-diagonal", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MapsArrowXmark = &Icon{Name: "maps-arrow-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MapsGoStraight = &Icon{Name: "maps-go-straight", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MapsTurnBack = &Icon{Name: "maps-turn-back", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MapsTurnLeft = &Icon{Name: "maps-turn-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MapsTurnRight = &Icon{Name: "maps-turn-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MaskSquare = &Icon{Name: "mask-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MastercardCard = &Icon{Name: "mastercard-card", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Mastodon = &Icon{Name: "mastodon", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MathBook = &Icon{Name: "math-book", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Maximize = &Icon{Name: "maximize", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Medal = &Icon{Name: "medal", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Medal1st = &Icon{Name: "medal-1st", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Medal1stSolid = &Icon{Name: "medal-1st-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	MedalSolid = &Icon{Name: "medal-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	MediaImage = &Icon{Name: "media-image", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MediaImageFolder = &Icon{Name: "media-image-folder", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MediaImageList = &Icon{Name: "media-image-list", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MediaImagePlus = &Icon{Name: "media-image-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MediaImageXmark = &Icon{Name: "media-image-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MediaVideo = &Icon{Name: "media-video", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MediaVideoFolder = &Icon{Name: "media-video-folder", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MediaVideoList = &Icon{Name: "media-video-list", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MediaVideoPlus = &Icon{Name: "media-video-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MediaVideoXmark = &Icon{Name: "media-video-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Medium = &Icon{Name: "medium", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Megaphone = &Icon{Name: "megaphone", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Menu = &Icon{Name: "menu", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MenuScale = &Icon{Name: "menu-scale", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Message = &Icon{Name: "message", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MessageAlert = &Icon{Name: "message-alert", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MessageAlertSolid = &Icon{Name: "message-alert-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	MessageSolid = &Icon{Name: "message-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	MessageText = &Icon{Name: "message-text", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MessageTextSolid = &Icon{Name: "message-text-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	MeterArrowDownRight = &Icon{Name: "meter-arrow-down-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Metro = &Icon{Name: "metro", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Microphone = &Icon{Name: "microphone", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MicrophoneCheck = &Icon{Name: "microphone-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MicrophoneCheckSolid = &Icon{Name: "microphone-check-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	MicrophoneMinus = &Icon{Name: "microphone-minus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MicrophoneMinusSolid = &Icon{Name: "microphone-minus-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	MicrophoneMute = &Icon{Name: "microphone-mute", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MicrophoneMuteSolid = &Icon{Name: "microphone-mute-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	MicrophonePlus = &Icon{Name: "microphone-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MicrophonePlusSolid = &Icon{Name: "microphone-plus-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	MicrophoneSolid = &Icon{Name: "microphone-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	MicrophoneSpeaking = &Icon{Name: "microphone-speaking", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MicrophoneSpeakingSolid = &Icon{Name: "microphone-speaking-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	MicrophoneWarning = &Icon{Name: "microphone-warning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MicrophoneWarningSolid = &Icon{Name: "microphone-warning-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Microscope = &Icon{Name: "microscope", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MicroscopeSolid = &Icon{Name: "microscope-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Minus = &Icon{Name: "minus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MinusCircle = &Icon{Name: "minus-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MinusCircleSolid = &Icon{Name: "minus-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	MinusHexagon = &Icon{Name: "minus-hexagon", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MinusSquare = &Icon{Name: "minus-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MinusSquareDashed = &Icon{Name: "minus-square-dashed", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MinusSquareSolid = &Icon{Name: "minus-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Mirror = &Icon{Name: "mirror", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MobileDevMode = &Icon{Name: "mobile-dev-mode", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MobileFingerprint = &Icon{Name: "mobile-fingerprint", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MobileVoice = &Icon{Name: "mobile-voice", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ModernTv = &Icon{Name: "modern-tv", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ModernTv4k = &Icon{Name: "modern-tv-4k", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MoneySquare = &Icon{Name: "money-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MoneySquareSolid = &Icon{Name: "money-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	MoonSat = &Icon{Name: "moon-sat", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MoreHoriz = &Icon{Name: "more-horiz", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MoreHorizCircle = &Icon{Name: "more-horiz-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MoreVert = &Icon{Name: "more-vert", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MoreVertCircle = &Icon{Name: "more-vert-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Motorcycle = &Icon{Name: "motorcycle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MouseButtonLeft = &Icon{Name: "mouse-button-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MouseButtonRight = &Icon{Name: "mouse-button-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MouseScrollWheel = &Icon{Name: "mouse-scroll-wheel", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Movie = &Icon{Name: "movie", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MpegFormat = &Icon{Name: "mpeg-format", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MultiBubble = &Icon{Name: "multi-bubble", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MultiBubbleSolid = &Icon{Name: "multi-bubble-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	MultiMacOsWindow = &Icon{Name: "multi-mac-os-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MultiWindow = &Icon{Name: "multi-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MultiplePages = &Icon{Name: "multiple-pages", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MultiplePagesEmpty = &Icon{Name: "multiple-pages-empty", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MultiplePagesMinus = &Icon{Name: "multiple-pages-minus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MultiplePagesPlus = &Icon{Name: "multiple-pages-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MultiplePagesXmark = &Icon{Name: "multiple-pages-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MusicDoubleNote = &Icon{Name: "music-double-note", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MusicDoubleNotePlus = &Icon{Name: "music-double-note-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MusicNote = &Icon{Name: "music-note", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MusicNotePlus = &Icon{Name: "music-note-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	MusicNotePlusSolid = &Icon{Name: "music-note-plus-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	MusicNoteSolid = &Icon{Name: "music-note-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	NSquare = &Icon{Name: "n-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	NavArrowDown = &Icon{Name: "nav-arrow-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	NavArrowLeft = &Icon{Name: "nav-arrow-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	NavArrowRight = &Icon{Name: "nav-arrow-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	NavArrowUp = &Icon{Name: "nav-arrow-up", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Navigator = &Icon{Name: "navigator", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	NavigatorAlt = &Icon{Name: "navigator-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Neighbourhood = &Icon{Name: "neighbourhood", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Network = &Icon{Name: "network", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	NetworkLeft = &Icon{Name: "network-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	NetworkLeftSolid = &Icon{Name: "network-left-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	NetworkReverse = &Icon{Name: "network-reverse", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	NetworkReverseSolid = &Icon{Name: "network-reverse-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	NetworkRight = &Icon{Name: "network-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	NetworkRightSolid = &Icon{Name: "network-right-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	NetworkSolid = &Icon{Name: "network-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	NewTab = &Icon{Name: "new-tab", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	NintendoSwitch = &Icon{Name: "nintendo-switch", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	NoSmokingCircle = &Icon{Name: "no-smoking-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	NonBinary = &Icon{Name: "non-binary", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Notes = &Icon{Name: "notes", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Npm = &Icon{Name: "npm", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	NpmSquare = &Icon{Name: "npm-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Number0Square = &Icon{Name: "number-0-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Number0SquareSolid = &Icon{Name: "number-0-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Number1Square = &Icon{Name: "number-1-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Number1SquareSolid = &Icon{Name: "number-1-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Number2Square = &Icon{Name: "number-2-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Number2SquareSolid = &Icon{Name: "number-2-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Number3Square = &Icon{Name: "number-3-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Number3SquareSolid = &Icon{Name: "number-3-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Number4Square = &Icon{Name: "number-4-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Number4SquareSolid = &Icon{Name: "number-4-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Number5Square = &Icon{Name: "number-5-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Number5SquareSolid = &Icon{Name: "number-5-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Number6Square = &Icon{Name: "number-6-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Number6SquareSolid = &Icon{Name: "number-6-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Number7Square = &Icon{Name: "number-7-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Number7SquareSolid = &Icon{Name: "number-7-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Number8Square = &Icon{Name: "number-8-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Number8SquareSolid = &Icon{Name: "number-8-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Number9Square = &Icon{Name: "number-9-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Number9SquareSolid = &Icon{Name: "number-9-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	NumberedListLeft = &Icon{Name: "numbered-list-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	NumberedListRight = &Icon{Name: "numbered-list-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	OSquare = &Icon{Name: "o-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Octagon = &Icon{Name: "octagon", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	OffTag = &Icon{Name: "off-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	OilIndustry = &Icon{Name: "oil-industry", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Okrs = &Icon{Name: "okrs", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	OnTag = &Icon{Name: "on-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	OneFingerSelectHandGesture = &Icon{Name: "one-finger-select-hand-gesture", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	OnePointCircle = &Icon{Name: "one-point-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	OpenBook = &Icon{Name: "open-book", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	OpenInBrowser = &Icon{Name: "open-in-browser", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	OpenInWindow = &Icon{Name: "open-in-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	OpenNewWindow = &Icon{Name: "open-new-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	OpenSelectHandGesture = &Icon{Name: "open-select-hand-gesture", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	OpenVpn = &Icon{Name: "open-vpn", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	OrangeHalf = &Icon{Name: "orange-half", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	OrangeSlice = &Icon{Name: "orange-slice", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	OrangeSliceAlt = &Icon{Name: "orange-slice-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	OrganicFood = &Icon{Name: "organic-food", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	OrganicFoodSquare = &Icon{Name: "organic-food-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	OrthogonalView = &Icon{Name: "orthogonal-view", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Package = &Icon{Name: "package", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PackageLock = &Icon{Name: "package-lock", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Packages = &Icon{Name: "packages", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Pacman = &Icon{Name: "pacman", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Page = &Icon{Name: "page", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PageDown = &Icon{Name: "page-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PageEdit = &Icon{Name: "page-edit", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PageFlip = &Icon{Name: "page-flip", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PageLeft = &Icon{Name: "page-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PageMinus = &Icon{Name: "page-minus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PageMinusIn = &Icon{Name: "page-minus-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PagePlus = &Icon{Name: "page-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PagePlusIn = &Icon{Name: "page-plus-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PageRight = &Icon{Name: "page-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PageSearch = &Icon{Name: "page-search", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PageStar = &Icon{Name: "page-star", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PageUp = &Icon{Name: "page-up", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Palette = &Icon{Name: "palette", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PanoramaEnlarge = &Icon{Name: "panorama-enlarge", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PanoramaReduce = &Icon{Name: "panorama-reduce", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Pants = &Icon{Name: "pants", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PantsPockets = &Icon{Name: "pants-pockets", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Parking = &Icon{Name: "parking", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PasswordCheck = &Icon{Name: "password-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PasswordCursor = &Icon{Name: "password-cursor", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PasswordXmark = &Icon{Name: "password-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PasteClipboard = &Icon{Name: "paste-clipboard", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PathArrow = &Icon{Name: "path-arrow", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Pause = &Icon{Name: "pause", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PauseSolid = &Icon{Name: "pause-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	PauseWindow = &Icon{Name: "pause-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Paypal = &Icon{Name: "paypal", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PcCheck = &Icon{Name: "pc-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PcFirewall = &Icon{Name: "pc-firewall", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PcMouse = &Icon{Name: "pc-mouse", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PcNoEntry = &Icon{Name: "pc-no-entry", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PcWarning = &Icon{Name: "pc-warning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PeaceHand = &Icon{Name: "peace-hand", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Peerlist = &Icon{Name: "peerlist", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PenConnectBluetooth = &Icon{Name: "pen-connect-bluetooth", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PenConnectWifi = &Icon{Name: "pen-connect-wifi", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PenTablet = &Icon{Name: "pen-tablet", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PenTabletConnectUsb = &Icon{Name: "pen-tablet-connect-usb", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PenTabletConnectWifi = &Icon{Name: "pen-tablet-connect-wifi", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Pentagon = &Icon{Name: "pentagon", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PeopleTag = &Icon{Name: "people-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PercentRotateOut = &Icon{Name: "percent-rotate-out", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Percentage = &Icon{Name: "percentage", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PercentageCircle = &Icon{Name: "percentage-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PercentageCircleSolid = &Icon{Name: "percentage-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	PercentageSquare = &Icon{Name: "percentage-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PercentageSquareSolid = &Icon{Name: "percentage-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	PerspectiveView = &Icon{Name: "perspective-view", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PharmacyCrossCircle = &Icon{Name: "pharmacy-cross-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PharmacyCrossTag = &Icon{Name: "pharmacy-cross-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Phone = &Icon{Name: "phone", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PhoneDisabled = &Icon{Name: "phone-disabled", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PhoneIncome = &Icon{Name: "phone-income", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PhoneIncomeSolid = &Icon{Name: "phone-income-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	PhoneMinus = &Icon{Name: "phone-minus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PhoneMinusSolid = &Icon{Name: "phone-minus-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	PhoneOutcome = &Icon{Name: "phone-outcome", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PhoneOutcomeSolid = &Icon{Name: "phone-outcome-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	PhonePaused = &Icon{Name: "phone-paused", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PhonePausedSolid = &Icon{Name: "phone-paused-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	PhonePlus = &Icon{Name: "phone-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PhonePlusSolid = &Icon{Name: "phone-plus-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	PhoneSolid = &Icon{Name: "phone-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	PhoneXmark = &Icon{Name: "phone-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PhoneXmarkSolid = &Icon{Name: "phone-xmark-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	PiggyBank = &Icon{Name: "piggy-bank", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Pillow = &Icon{Name: "pillow", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Pin = &Icon{Name: "pin", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PinSlash = &Icon{Name: "pin-slash", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PinSlashSolid = &Icon{Name: "pin-slash-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	PinSolid = &Icon{Name: "pin-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	PineTree = &Icon{Name: "pine-tree", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Pinterest = &Icon{Name: "pinterest", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Pipe3d = &Icon{Name: "pipe-3d", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PizzaSlice = &Icon{Name: "pizza-slice", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Planet = &Icon{Name: "planet", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PlanetAlt = &Icon{Name: "planet-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PlanetSat = &Icon{Name: "planet-sat", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PlanetSolid = &Icon{Name: "planet-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Planimetry = &Icon{Name: "planimetry", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Play = &Icon{Name: "play", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PlaySolid = &Icon{Name: "play-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Playlist = &Icon{Name: "playlist", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PlaylistPlay = &Icon{Name: "playlist-play", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PlaylistPlus = &Icon{Name: "playlist-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PlaystationGamepad = &Icon{Name: "playstation-gamepad", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PlugTypeA = &Icon{Name: "plug-type-a", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PlugTypeC = &Icon{Name: "plug-type-c", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PlugTypeG = &Icon{Name: "plug-type-g", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PlugTypeL = &Icon{Name: "plug-type-l", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Plus = &Icon{Name: "plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PlusCircle = &Icon{Name: "plus-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PlusCircleSolid = &Icon{Name: "plus-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	PlusSquare = &Icon{Name: "plus-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PlusSquareDashed = &Icon{Name: "plus-square-dashed", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PlusSquareSolid = &Icon{Name: "plus-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	PngFormat = &Icon{Name: "png-format", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Pocket = &Icon{Name: "pocket", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Podcast = &Icon{Name: "podcast", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PodcastSolid = &Icon{Name: "podcast-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Pokeball = &Icon{Name: "pokeball", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PolarSh = &Icon{Name: "polar-sh", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Position = &Icon{Name: "position", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PositionAlign = &Icon{Name: "position-align", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Post = &Icon{Name: "post", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PostSolid = &Icon{Name: "post-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Potion = &Icon{Name: "potion", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Pound = &Icon{Name: "pound", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PrecisionTool = &Icon{Name: "precision-tool", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Presentation = &Icon{Name: "presentation", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PresentationSolid = &Icon{Name: "presentation-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Printer = &Icon{Name: "printer", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PrintingPage = &Icon{Name: "printing-page", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PriorityDown = &Icon{Name: "priority-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PriorityDownSolid = &Icon{Name: "priority-down-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	PriorityHigh = &Icon{Name: "priority-high", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PriorityHighSolid = &Icon{Name: "priority-high-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	PriorityMedium = &Icon{Name: "priority-medium", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PriorityMediumSolid = &Icon{Name: "priority-medium-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	PriorityUp = &Icon{Name: "priority-up", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PriorityUpSolid = &Icon{Name: "priority-up-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	PrivacyPolicy = &Icon{Name: "privacy-policy", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	PrivateWifi = &Icon{Name: "private-wifi", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ProfileCircle = &Icon{Name: "profile-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Prohibition = &Icon{Name: "prohibition", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ProjectCurve3d = &Icon{Name: "project-curve-3d", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Puzzle = &Icon{Name: "puzzle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	QrCode = &Icon{Name: "qr-code", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	QuestionMark = &Icon{Name: "question-mark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Quote = &Icon{Name: "quote", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	QuoteMessage = &Icon{Name: "quote-message", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	QuoteMessageSolid = &Icon{Name: "quote-message-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	QuoteSolid = &Icon{Name: "quote-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Radiation = &Icon{Name: "radiation", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RadiationSolid = &Icon{Name: "radiation-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Radius = &Icon{Name: "radius", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RadiusSolid = &Icon{Name: "radius-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Rain = &Icon{Name: "rain", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RawFormat = &Icon{Name: "raw-format", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ReceiveDollars = &Icon{Name: "receive-dollars", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ReceiveEuros = &Icon{Name: "receive-euros", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ReceivePounds = &Icon{Name: "receive-pounds", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ReceiveYens = &Icon{Name: "receive-yens", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Redo = &Icon{Name: "redo", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RedoAction = &Icon{Name: "redo-action", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RedoCircle = &Icon{Name: "redo-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RedoCircleSolid = &Icon{Name: "redo-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Reduce = &Icon{Name: "reduce", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Refresh = &Icon{Name: "refresh", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RefreshCircle = &Icon{Name: "refresh-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RefreshCircleSolid = &Icon{Name: "refresh-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	RefreshDouble = &Icon{Name: "refresh-double", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ReloadWindow = &Icon{Name: "reload-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ReminderHandGesture = &Icon{Name: "reminder-hand-gesture", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Repeat = &Icon{Name: "repeat", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RepeatOnce = &Icon{Name: "repeat-once", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Reply = &Icon{Name: "reply", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ReplyToMessage = &Icon{Name: "reply-to-message", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ReportColumns = &Icon{Name: "report-columns", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Reports = &Icon{Name: "reports", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ReportsSolid = &Icon{Name: "reports-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Repository = &Icon{Name: "repository", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Restart = &Icon{Name: "restart", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Rewind = &Icon{Name: "rewind", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RewindSolid = &Icon{Name: "rewind-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Rhombus = &Icon{Name: "rhombus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RhombusArrowRight = &Icon{Name: "rhombus-arrow-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RhombusArrowRightSolid = &Icon{Name: "rhombus-arrow-right-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Rings = &Icon{Name: "rings", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Rocket = &Icon{Name: "rocket", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Rook = &Icon{Name: "rook", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RotateCameraLeft = &Icon{Name: "rotate-camera-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RotateCameraRight = &Icon{Name: "rotate-camera-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RoundFlask = &Icon{Name: "round-flask", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RoundFlaskSolid = &Icon{Name: "round-flask-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	RoundedMirror = &Icon{Name: "rounded-mirror", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RssFeed = &Icon{Name: "rss-feed", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RssFeedTag = &Icon{Name: "rss-feed-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RubikCube = &Icon{Name: "rubik-cube", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Ruler = &Icon{Name: "ruler", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RulerArrows = &Icon{Name: "ruler-arrows", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RulerCombine = &Icon{Name: "ruler-combine", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RulerMinus = &Icon{Name: "ruler-minus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	RulerPlus = &Icon{Name: "ruler-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Running = &Icon{Name: "running", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Safari = &Icon{Name: "safari", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Safe = &Icon{Name: "safe", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SafeArrowLeft = &Icon{Name: "safe-arrow-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SafeArrowRight = &Icon{Name: "safe-arrow-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SafeOpen = &Icon{Name: "safe-open", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Sandals = &Icon{Name: "sandals", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ScaleFrameEnlarge = &Icon{Name: "scale-frame-enlarge", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ScaleFrameReduce = &Icon{Name: "scale-frame-reduce", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ScanBarcode = &Icon{Name: "scan-barcode", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ScanQrCode = &Icon{Name: "scan-qr-code", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Scanning = &Icon{Name: "scanning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Scarf = &Icon{Name: "scarf", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Scissor = &Icon{Name: "scissor", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ScissorAlt = &Icon{Name: "scissor-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Screenshot = &Icon{Name: "screenshot", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SeaAndSun = &Icon{Name: "sea-and-sun", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SeaWaves = &Icon{Name: "sea-waves", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Search = &Icon{Name: "search", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SearchEngine = &Icon{Name: "search-engine", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SearchWindow = &Icon{Name: "search-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SecureWindow = &Icon{Name: "secure-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SecurityPass = &Icon{Name: "security-pass", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SelectEdge3d = &Icon{Name: "select-edge-3d", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SelectFace3d = &Icon{Name: "select-face-3d", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SelectPoint3d = &Icon{Name: "select-point-3d", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SelectWindow = &Icon{Name: "select-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SelectiveTool = &Icon{Name: "selective-tool", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Send = &Icon{Name: "send", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SendDiagonal = &Icon{Name: "send-diagonal", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SendDiagonalSolid = &Icon{Name: "send-diagonal-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	SendDollars = &Icon{Name: "send-dollars", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SendEuros = &Icon{Name: "send-euros", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SendMail = &Icon{Name: "send-mail", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SendMailSolid = &Icon{Name: "send-mail-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	SendPounds = &Icon{Name: "send-pounds", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SendSolid = &Icon{Name: "send-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	SendYens = &Icon{Name: "send-yens", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Server = &Icon{Name: "server", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ServerConnection = &Icon{Name: "server-connection", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ServerConnectionSolid = &Icon{Name: "server-connection-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ServerSolid = &Icon{Name: "server-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Settings = &Icon{Name: "settings", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SettingsProfiles = &Icon{Name: "settings-profiles", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShareAndroid = &Icon{Name: "share-android", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShareAndroidSolid = &Icon{Name: "share-android-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ShareIos = &Icon{Name: "share-ios", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Shield = &Icon{Name: "shield", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShieldAlert = &Icon{Name: "shield-alert", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShieldAlt = &Icon{Name: "shield-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShieldBroken = &Icon{Name: "shield-broken", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShieldCheck = &Icon{Name: "shield-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShieldDownload = &Icon{Name: "shield-download", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShieldEye = &Icon{Name: "shield-eye", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShieldLoading = &Icon{Name: "shield-loading", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShieldMinus = &Icon{Name: "shield-minus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShieldPlusIn = &Icon{Name: "shield-plus-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShieldQuestion = &Icon{Name: "shield-question", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShieldSearch = &Icon{Name: "shield-search", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShieldUpload = &Icon{Name: "shield-upload", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShieldXmark = &Icon{Name: "shield-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Shirt = &Icon{Name: "shirt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShirtTankTop = &Icon{Name: "shirt-tank-top", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Shop = &Icon{Name: "shop", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShopFourTiles = &Icon{Name: "shop-four-tiles", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShopFourTilesWindow = &Icon{Name: "shop-four-tiles-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShopWindow = &Icon{Name: "shop-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShoppingBag = &Icon{Name: "shopping-bag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShoppingBagArrowDown = &Icon{Name: "shopping-bag-arrow-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShoppingBagArrowUp = &Icon{Name: "shopping-bag-arrow-up", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShoppingBagCheck = &Icon{Name: "shopping-bag-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShoppingBagMinus = &Icon{Name: "shopping-bag-minus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShoppingBagPlus = &Icon{Name: "shopping-bag-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShoppingBagPocket = &Icon{Name: "shopping-bag-pocket", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShoppingBagWarning = &Icon{Name: "shopping-bag-warning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShoppingCode = &Icon{Name: "shopping-code", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShoppingCodeCheck = &Icon{Name: "shopping-code-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShoppingCodeXmark = &Icon{Name: "shopping-code-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShortPants = &Icon{Name: "short-pants", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShortPantsPockets = &Icon{Name: "short-pants-pockets", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ShortcutSquare = &Icon{Name: "shortcut-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Shuffle = &Icon{Name: "shuffle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SidebarCollapse = &Icon{Name: "sidebar-collapse", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SidebarExpand = &Icon{Name: "sidebar-expand", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SigmaFunction = &Icon{Name: "sigma-function", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SimpleCart = &Icon{Name: "simple-cart", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SineWave = &Icon{Name: "sine-wave", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SingleTapGesture = &Icon{Name: "single-tap-gesture", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Skateboard = &Icon{Name: "skateboard", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Skateboarding = &Icon{Name: "skateboarding", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SkipNext = &Icon{Name: "skip-next", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SkipNextSolid = &Icon{Name: "skip-next-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	SkipPrev = &Icon{Name: "skip-prev", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SkipPrevSolid = &Icon{Name: "skip-prev-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Slash = &Icon{Name: "slash", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SlashSquare = &Icon{Name: "slash-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SleeperChair = &Icon{Name: "sleeper-chair", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Slips = &Icon{Name: "slips", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SmallLamp = &Icon{Name: "small-lamp", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SmallLampAlt = &Icon{Name: "small-lamp-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SmartphoneDevice = &Icon{Name: "smartphone-device", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Smoking = &Icon{Name: "smoking", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Snapchat = &Icon{Name: "snapchat", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Snow = &Icon{Name: "snow", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SnowFlake = &Icon{Name: "snow-flake", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Soap = &Icon{Name: "soap", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SoccerBall = &Icon{Name: "soccer-ball", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Sofa = &Icon{Name: "sofa", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Soil = &Icon{Name: "soil", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SoilAlt = &Icon{Name: "soil-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Sort = &Icon{Name: "sort", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SortDown = &Icon{Name: "sort-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SortUp = &Icon{Name: "sort-up", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SoundHigh = &Icon{Name: "sound-high", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SoundHighSolid = &Icon{Name: "sound-high-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	SoundLow = &Icon{Name: "sound-low", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SoundLowSolid = &Icon{Name: "sound-low-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	SoundMin = &Icon{Name: "sound-min", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SoundMinSolid = &Icon{Name: "sound-min-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	SoundOff = &Icon{Name: "sound-off", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SoundOffSolid = &Icon{Name: "sound-off-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Spades = &Icon{Name: "spades", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Spark = &Icon{Name: "spark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SparkSolid = &Icon{Name: "spark-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Sparks = &Icon{Name: "sparks", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SparksSolid = &Icon{Name: "sparks-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Sphere = &Icon{Name: "sphere", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Spiral = &Icon{Name: "spiral", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SplitArea = &Icon{Name: "split-area", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SplitSquareDashed = &Icon{Name: "split-square-dashed", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SpockHandGesture = &Icon{Name: "spock-hand-gesture", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Spotify = &Icon{Name: "spotify", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Square = &Icon{Name: "square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Square3dCornerToCorner = &Icon{Name: "square-3d-corner-to-corner", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Square3dFromCenter = &Icon{Name: "square-3d-from-center", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Square3dThreePoints = &Icon{Name: "square-3d-three-points", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SquareCursor = &Icon{Name: "square-cursor", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SquareCursorSolid = &Icon{Name: "square-cursor-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	SquareDashed = &Icon{Name: "square-dashed", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SquareWave = &Icon{Name: "square-wave", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Stackoverflow = &Icon{Name: "stackoverflow", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Star = &Icon{Name: "star", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	StarDashed = &Icon{Name: "star-dashed", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	StarHalfDashed = &Icon{Name: "star-half-dashed", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	StarSolid = &Icon{Name: "star-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	StatDown = &Icon{Name: "stat-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	StatUp = &Icon{Name: "stat-up", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	StatsDownSquare = &Icon{Name: "stats-down-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	StatsDownSquareSolid = &Icon{Name: "stats-down-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	StatsReport = &Icon{Name: "stats-report", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	StatsUpSquare = &Icon{Name: "stats-up-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	StatsUpSquareSolid = &Icon{Name: "stats-up-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Strategy = &Icon{Name: "strategy", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Stretching = &Icon{Name: "stretching", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Strikethrough = &Icon{Name: "strikethrough", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Stroller = &Icon{Name: "stroller", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	StyleBorder = &Icon{Name: "style-border", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	StyleBorderSolid = &Icon{Name: "style-border-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	SubmitDocument = &Icon{Name: "submit-document", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Substract = &Icon{Name: "substract", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Suggestion = &Icon{Name: "suggestion", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Suitcase = &Icon{Name: "suitcase", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SunLight = &Icon{Name: "sun-light", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SvgFormat = &Icon{Name: "svg-format", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Sweep3d = &Icon{Name: "sweep-3d", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Swimming = &Icon{Name: "swimming", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SwipeDownGesture = &Icon{Name: "swipe-down-gesture", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SwipeLeftGesture = &Icon{Name: "swipe-left-gesture", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SwipeRightGesture = &Icon{Name: "swipe-right-gesture", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SwipeTwoFingersDownGesture = &Icon{Name: "swipe-two-fingers-down-gesture", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SwipeTwoFingersLeftGesture = &Icon{Name: "swipe-two-fingers-left-gesture", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SwipeTwoFingersRightGesture = &Icon{Name: "swipe-two-fingers-right-gesture", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SwipeTwoFingersUpGesture = &Icon{Name: "swipe-two-fingers-up-gesture", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SwipeUpGesture = &Icon{Name: "swipe-up-gesture", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SwitchOff = &Icon{Name: "switch-off", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SwitchOn = &Icon{Name: "switch-on", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SystemRestart = &Icon{Name: "system-restart", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	SystemShut = &Icon{Name: "system-shut", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Table = &Icon{Name: "table", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Table2Columns = &Icon{Name: "table-2-columns", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TableRows = &Icon{Name: "table-rows", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TaskList = &Icon{Name: "task-list", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Telegram = &Icon{Name: "telegram", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TelegramCircle = &Icon{Name: "telegram-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TemperatureDown = &Icon{Name: "temperature-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TemperatureHigh = &Icon{Name: "temperature-high", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TemperatureLow = &Icon{Name: "temperature-low", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TemperatureUp = &Icon{Name: "temperature-up", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TennisBall = &Icon{Name: "tennis-ball", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TennisBallAlt = &Icon{Name: "tennis-ball-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Terminal = &Icon{Name: "terminal", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TerminalTag = &Icon{Name: "terminal-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TestTube = &Icon{Name: "test-tube", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TestTubeSolid = &Icon{Name: "test-tube-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Text = &Icon{Name: "text", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TextArrowsUpDown = &Icon{Name: "text-arrows-up-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TextBox = &Icon{Name: "text-box", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TextMagnifyingGlass = &Icon{Name: "text-magnifying-glass", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TextSize = &Icon{Name: "text-size", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TextSquare = &Icon{Name: "text-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TextSquareSolid = &Icon{Name: "text-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Threads = &Icon{Name: "threads", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ThreePointsCircle = &Icon{Name: "three-points-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ThreeStars = &Icon{Name: "three-stars", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ThreeStarsSolid = &Icon{Name: "three-stars-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	ThumbsDown = &Icon{Name: "thumbs-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ThumbsUp = &Icon{Name: "thumbs-up", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Thunderstorm = &Icon{Name: "thunderstorm", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TifFormat = &Icon{Name: "tif-format", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TiffFormat = &Icon{Name: "tiff-format", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Tiktok = &Icon{Name: "tiktok", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TimeZone = &Icon{Name: "time-zone", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Timer = &Icon{Name: "timer", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TimerOff = &Icon{Name: "timer-off", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TimerSolid = &Icon{Name: "timer-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Tools = &Icon{Name: "tools", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Tournament = &Icon{Name: "tournament", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Tower = &Icon{Name: "tower", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TowerCheck = &Icon{Name: "tower-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TowerNoAccess = &Icon{Name: "tower-no-access", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TowerWarning = &Icon{Name: "tower-warning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Trademark = &Icon{Name: "trademark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Train = &Icon{Name: "train", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Tram = &Icon{Name: "tram", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TransitionDown = &Icon{Name: "transition-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TransitionDownSolid = &Icon{Name: "transition-down-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	TransitionLeft = &Icon{Name: "transition-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TransitionLeftSolid = &Icon{Name: "transition-left-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	TransitionRight = &Icon{Name: "transition-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TransitionRightSolid = &Icon{Name: "transition-right-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	TransitionUp = &Icon{Name: "transition-up", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TransitionUpSolid = &Icon{Name: "transition-up-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Translate = &Icon{Name: "translate", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Trash = &Icon{Name: "trash", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TrashSolid = &Icon{Name: "trash-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Treadmill = &Icon{Name: "treadmill", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Tree = &Icon{Name: "tree", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Trekking = &Icon{Name: "trekking", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Trello = &Icon{Name: "trello", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Triangle = &Icon{Name: "triangle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TriangleFlag = &Icon{Name: "triangle-flag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TriangleFlagCircle = &Icon{Name: "triangle-flag-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TriangleFlagTwoStripes = &Icon{Name: "triangle-flag-two-stripes", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Trophy = &Icon{Name: "trophy", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Truck = &Icon{Name: "truck", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TruckGreen = &Icon{Name: "truck-green", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TruckLength = &Icon{Name: "truck-length", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Tunnel = &Icon{Name: "tunnel", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Tv = &Icon{Name: "tv", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TvFix = &Icon{Name: "tv-fix", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TvWarning = &Icon{Name: "tv-warning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Twitter = &Icon{Name: "twitter", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TwoPointsCircle = &Icon{Name: "two-points-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	TwoSeaterSofa = &Icon{Name: "two-seater-sofa", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Type = &Icon{Name: "type", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UTurnArrowLeft = &Icon{Name: "u-turn-arrow-left", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UTurnArrowRight = &Icon{Name: "u-turn-arrow-right", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Umbrella = &Icon{Name: "umbrella", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Underline = &Icon{Name: "underline", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UnderlineSquare = &Icon{Name: "underline-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UnderlineSquareSolid = &Icon{Name: "underline-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Undo = &Icon{Name: "undo", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UndoAction = &Icon{Name: "undo-action", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UndoCircle = &Icon{Name: "undo-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UndoCircleSolid = &Icon{Name: "undo-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Union = &Icon{Name: "union", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UnionAlt = &Icon{Name: "union-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UnionHorizAlt = &Icon{Name: "union-horiz-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Unity = &Icon{Name: "unity", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Unity5 = &Icon{Name: "unity-5", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Unjoin3d = &Icon{Name: "unjoin-3d", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Upload = &Icon{Name: "upload", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UploadDataWindow = &Icon{Name: "upload-data-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UploadSquare = &Icon{Name: "upload-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UploadSquareSolid = &Icon{Name: "upload-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Usb = &Icon{Name: "usb", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UsbSolid = &Icon{Name: "usb-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	User = &Icon{Name: "user", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UserBadgeCheck = &Icon{Name: "user-badge-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UserBag = &Icon{Name: "user-bag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UserCart = &Icon{Name: "user-cart", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UserCircle = &Icon{Name: "user-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UserCrown = &Icon{Name: "user-crown", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UserLove = &Icon{Name: "user-love", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UserPlus = &Icon{Name: "user-plus", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UserScan = &Icon{Name: "user-scan", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UserSquare = &Icon{Name: "user-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UserStar = &Icon{Name: "user-star", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	UserXmark = &Icon{Name: "user-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Vegan = &Icon{Name: "vegan", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	VeganCircle = &Icon{Name: "vegan-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	VeganSquare = &Icon{Name: "vegan-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	VehicleGreen = &Icon{Name: "vehicle-green", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	VerifiedBadge = &Icon{Name: "verified-badge", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	VerticalMerge = &Icon{Name: "vertical-merge", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	VerticalSplit = &Icon{Name: "vertical-split", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Vials = &Icon{Name: "vials", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	VialsSolid = &Icon{Name: "vials-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	VideoCamera = &Icon{Name: "video-camera", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	VideoCameraOff = &Icon{Name: "video-camera-off", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	VideoProjector = &Icon{Name: "video-projector", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	View360 = &Icon{Name: "view-360", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ViewColumns2 = &Icon{Name: "view-columns-2", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ViewColumns3 = &Icon{Name: "view-columns-3", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ViewGrid = &Icon{Name: "view-grid", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ViewStructureDown = &Icon{Name: "view-structure-down", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ViewStructureUp = &Icon{Name: "view-structure-up", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Voice = &Icon{Name: "voice", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	VoiceCheck = &Icon{Name: "voice-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	VoiceCircle = &Icon{Name: "voice-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	VoiceLockCircle = &Icon{Name: "voice-lock-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	VoiceScan = &Icon{Name: "voice-scan", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	VoiceSquare = &Icon{Name: "voice-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	VoiceXmark = &Icon{Name: "voice-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	VrTag = &Icon{Name: "vr-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	VueJs = &Icon{Name: "vue-js", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Waist = &Icon{Name: "waist", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Walking = &Icon{Name: "walking", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Wallet = &Icon{Name: "wallet", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WalletSolid = &Icon{Name: "wallet-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	WarningCircle = &Icon{Name: "warning-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WarningCircleSolid = &Icon{Name: "warning-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	WarningHexagon = &Icon{Name: "warning-hexagon", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WarningSquare = &Icon{Name: "warning-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WarningSquareSolid = &Icon{Name: "warning-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	WarningTriangle = &Icon{Name: "warning-triangle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WarningTriangleSolid = &Icon{Name: "warning-triangle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	WarningWindow = &Icon{Name: "warning-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Wash = &Icon{Name: "wash", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WashingMachine = &Icon{Name: "washing-machine", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WateringSoil = &Icon{Name: "watering-soil", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WebWindow = &Icon{Name: "web-window", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WebWindowEnergyConsumption = &Icon{Name: "web-window-energy-consumption", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WebWindowEnergyConsumptionSolid = &Icon{Name: "web-window-energy-consumption-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	WebWindowSolid = &Icon{Name: "web-window-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	WebWindowXmark = &Icon{Name: "web-window-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WebWindowXmarkSolid = &Icon{Name: "web-window-xmark-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	WebpFormat = &Icon{Name: "webp-format", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Weight = &Icon{Name: "weight", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WeightAlt = &Icon{Name: "weight-alt", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WhiteFlag = &Icon{Name: "white-flag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WhiteFlagSolid = &Icon{Name: "white-flag-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Wifi = &Icon{Name: "wifi", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WifiOff = &Icon{Name: "wifi-off", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WifiSignalNone = &Icon{Name: "wifi-signal-none", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WifiSignalNoneSolid = &Icon{Name: "wifi-signal-none-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	WifiTag = &Icon{Name: "wifi-tag", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WifiTagSolid = &Icon{Name: "wifi-tag-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	WifiWarning = &Icon{Name: "wifi-warning", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WifiWarningSolid = &Icon{Name: "wifi-warning-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	WifiXmark = &Icon{Name: "wifi-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Wind = &Icon{Name: "wind", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WindowCheck = &Icon{Name: "window-check", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WindowLock = &Icon{Name: "window-lock", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WindowNoAccess = &Icon{Name: "window-no-access", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WindowTabs = &Icon{Name: "window-tabs", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WindowTabsSolid = &Icon{Name: "window-tabs-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	WindowXmark = &Icon{Name: "window-xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Windows = &Icon{Name: "windows", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Wolf = &Icon{Name: "wolf", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	WolfSolid = &Icon{Name: "wolf-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	WrapText = &Icon{Name: "wrap-text", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Wrench = &Icon{Name: "wrench", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Wristwatch = &Icon{Name: "wristwatch", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Www = &Icon{Name: "www", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	X = &Icon{Name: "x", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	XSquare = &Icon{Name: "x-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	XboxA = &Icon{Name: "xbox-a", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	XboxB = &Icon{Name: "xbox-b", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	XboxX = &Icon{Name: "xbox-x", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	XboxY = &Icon{Name: "xbox-y", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Xmark = &Icon{Name: "xmark", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	XmarkCircle = &Icon{Name: "xmark-circle", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	XmarkCircleSolid = &Icon{Name: "xmark-circle-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	XmarkSquare = &Icon{Name: "xmark-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	XmarkSquareSolid = &Icon{Name: "xmark-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	XrayView = &Icon{Name: "xray-view", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	YSquare = &Icon{Name: "y-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Yelp = &Icon{Name: "yelp", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Yen = &Icon{Name: "yen", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	YenSquare = &Icon{Name: "yen-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	YenSquareSolid = &Icon{Name: "yen-square-solid", Type: "Solid", Size: "24", Width: 24, Height: 24}
+	Yoga = &Icon{Name: "yoga", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	Youtube = &Icon{Name: "youtube", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ZSquare = &Icon{Name: "z-square", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ZoomIn = &Icon{Name: "zoom-in", Type: "Outline", Size: "24", Width: 24, Height: 24}
+	ZoomOut = &Icon{Name: "zoom-out", Type: "Outline", Size: "24", Width: 24, Height: 24}
 )
+
+var iconRegistry = map[string]*Icon{
+	"1st-medal": Medal1st,
+	"2x2-cell": Cell2x2,
+	"360-view": View360,
+	"3d-add-hole": CubeHole,
+	"3d-arc": Arc3d,
+	"3d-arc-center-pt": Arc3dCenterPoint,
+	"3d-bridge": Bridge3d,
+	"3d-center-box": Box3dCenter,
+	"3d-draft-face": Face3dDraft,
+	"3d-ellipse": Ellipse3d,
+	"3d-ellipse-three-pts": Ellipse3dThreePoints,
+	"3d-pt-box": Box3dPoint,
+	"3d-rect-corner-to-corner": Square3dCornerToCorner,
+	"3d-rect-from-center": Square3dFromCenter,
+	"3d-rect-three-pts": Square3dThreePoints,
+	"3d-select-edge": SelectEdge3d,
+	"3d-select-face": SelectFace3d,
+	"3d-select-point": SelectPoint3d,
+	"3d-select-solid": Cube,
+	"3d-three-pts-box": Box3dThreePoints,
+	"4k-display": Display4k,
+	"4x4-cell": Cell2x2,
+	"accessibility": Accessibility,
+	"accessibility-sign": AccessibilitySign,
+	"accessibility-tech": AccessibilityTech,
+	"activity": Activity,
+	"add-circle": PlusCircle,
+	"add-circled-outline": PlusCircle,
+	"add-database-script": DatabaseScriptPlus,
+	"add-folder": FolderPlus,
+	"add-frame": FramePlusIn,
+	"add-hexagon": HexagonPlus,
+	"add-keyframe": KeyframePlus,
+	"add-keyframe-alt": KeyframePlusIn,
+	"add-keyframes": KeyframesPlus,
+	"add-lens": LensPlus,
+	"add-media-image": MediaImagePlus,
+	"add-media-video": MediaVideoPlus,
+	"add-page": PagePlusIn,
+	"add-page-alt": PagePlus,
+	"add-pin-alt": MapPinPlus,
+	"add-selection": PlusSquareDashed,
+	"add-square": PlusSquare,
+	"add-to-cart": CartPlus,
+	"add-user": UserPlus,
+	"adobe-after-effects": AdobeAfterEffects,
+	"adobe-after-effects-solid": AdobeAfterEffectsSolid,
+	"adobe-illustrator": AdobeIllustrator,
+	"adobe-illustrator-solid": AdobeIllustratorSolid,
+	"adobe-indesign": AdobeIndesign,
+	"adobe-indesign-solid": AdobeIndesignSolid,
+	"adobe-lightroom": AdobeLightroom,
+	"adobe-lightroom-solid": AdobeLightroomSolid,
+	"adobe-photoshop": AdobePhotoshop,
+	"adobe-photoshop-solid": AdobePhotoshopSolid,
+	"adobe-xd": AdobeXd,
+	"adobe-xd-solid": AdobeXdSolid,
+	"african-tree": AfricanTree,
+	"agile": Agile,
+	"air-conditioner": AirConditioner,
+	"airplane": Airplane,
+	"airplane-helix": AirplaneHelix,
+	"airplane-helix-45deg": AirplaneHelix45deg,
+	"airplane-off": AirplaneOff,
+	"airplane-rotation": AirplaneRotation,
+	"airplay": Airplay,
+	"airplay-solid": AirplaySolid,
+	"alarm": Alarm,
+	"alarm-solid": AlarmSolid,
+	"album": Album,
+	"album-carousel": AlbumCarousel,
+	"album-list": AlbumList,
+	"album-open": AlbumOpen,
+	"align-bottom-box": AlignBottomBox,
+	"align-bottom-box-solid": AlignBottomBoxSolid,
+	"align-center": AlignCenter,
+	"align-horizontal-centers": AlignHorizontalCenters,
+	"align-horizontal-centers-solid": AlignHorizontalCentersSolid,
+	"align-horizontal-spacing": AlignHorizontalSpacing,
+	"align-horizontal-spacing-solid": AlignHorizontalSpacingSolid,
+	"align-justify": AlignJustify,
+	"align-left": AlignLeft,
+	"align-left-box": AlignLeftBox,
+	"align-left-box-solid": AlignLeftBoxSolid,
+	"align-right": AlignRight,
+	"align-right-box": AlignRightBox,
+	"align-right-box-solid": AlignRightBoxSolid,
+	"align-top-box": AlignTopBox,
+	"align-top-box-solid": AlignTopBoxSolid,
+	"align-vertical-centers": AlignVerticalCenters,
+	"align-vertical-centers-solid": AlignVerticalCentersSolid,
+	"align-vertical-spacing": AlignVerticalSpacing,
+	"align-vertical-spacing-solid": AlignVerticalSpacingSolid,
+	"angle-tool": AngleTool,
+	"antenna": Antenna,
+	"antenna-off": AntennaOff,
+	"antenna-signal": AntennaSignal,
+	"antenna-signal-rounded": AntennaSignalTag,
+	"antenna-signal-tag": AntennaSignalTag,
+	"app-notification": AppNotification,
+	"app-notification-solid": AppNotificationSolid,
+	"app-store": AppStore,
+	"app-store-solid": AppStoreSolid,
+	"app-window": AppWindow,
+	"apple": Apple,
+	"apple-half": AppleHalf,
+	"apple-half-alt": AppleHalfAlt,
+	"apple-imac-2021": AppleImac21,
+	"apple-imac-2021-side": AppleImac21Side,
+	"apple-mac": AppleMac,
+	"apple-shortcuts": AppleShortcuts,
+	"apple-shortcuts-solid": AppleShortcutsSolid,
+	"apple-swift": AppleSwift,
+	"apple-wallet": AppleWallet,
+	"ar-symbol": ArTag,
+	"ar-tag": ArTag,
+	"arc-3d": Arc3d,
+	"arc-3d-center-point": Arc3dCenterPoint,
+	"arcade": Arcade,
+	"archery": Archery,
+	"archery-match": ArcheryMatch,
+	"archive": Archive,
+	"area-search": AreaSearch,
+	"arrow-archery": ArrowArchery,
+	"arrow-bl": ArrowDownLeft,
+	"arrow-bl-circle": ArrowDownLeftCircle,
+	"arrow-bl-circled": ArrowDownLeftCircle,
+	"arrow-bl-square": ArrowDownLeftSquare,
+	"arrow-br": ArrowDownRight,
+	"arrow-br-circle": ArrowDownRightCircle,
+	"arrow-br-circled": ArrowDownRightCircle,
+	"arrow-br-square": ArrowDownRightSquare,
+	"arrow-down": ArrowDown,
+	"arrow-down-circle": ArrowDownCircle,
+	"arrow-down-circle-solid": ArrowDownCircleSolid,
+	"arrow-down-circled": ArrowDownCircle,
+	"arrow-down-left": ArrowDownLeft,
+	"arrow-down-left-circle": ArrowDownLeftCircle,
+	"arrow-down-left-circle-solid": ArrowDownLeftCircleSolid,
+	"arrow-down-left-square": ArrowDownLeftSquare,
+	"arrow-down-right": ArrowDownRight,
+	"arrow-down-right-circle": ArrowDownRightCircle,
+	"arrow-down-right-circle-solid": ArrowDownRightCircleSolid,
+	"arrow-down-right-square": ArrowDownRightSquare,
+	"arrow-down-right-square-solid": ArrowDownRightSquareSolid,
+	"arrow-down-tag": ArrowDownTag,
+	"arrow-email-forward": ArrowEmailForward,
+	"arrow-enlarge-tag": ArrowEnlargeTag,
+	"arrow-left": ArrowLeft,
+	"arrow-left-circle": ArrowLeftCircle,
+	"arrow-left-circle-solid": ArrowLeftCircleSolid,
+	"arrow-left-circled": ArrowLeftCircle,
+	"arrow-left-tag": ArrowLeftTag,
+	"arrow-reduce-tag": ArrowReduceTag,
+	"arrow-right": ArrowRight,
+	"arrow-right-circle": ArrowRightCircle,
+	"arrow-right-circle-solid": ArrowRightCircleSolid,
+	"arrow-right-circled": ArrowRightCircle,
+	"arrow-right-tag": ArrowRightTag,
+	"arrow-separate": ArrowSeparate,
+	"arrow-separate-vertical": ArrowSeparateVertical,
+	"arrow-tl": ArrowUpLeft,
+	"arrow-tl-circle": ArrowUpLeftCircle,
+	"arrow-tl-circled": ArrowUpLeftCircle,
+	"arrow-tl-square": ArrowUpLeftSquare,
+	"arrow-tr": ArrowUpRight,
+	"arrow-tr-circle": ArrowUpRightCircle,
+	"arrow-tr-circled": ArrowUpRightCircle,
+	"arrow-tr-square": ArrowUpRightSquare,
+	"arrow-union": ArrowUnion,
+	"arrow-union-vertical": ArrowUnionVertical,
+	"arrow-up": ArrowUp,
+	"arrow-up-circle": ArrowUpCircle,
+	"arrow-up-circle-solid": ArrowUpCircleSolid,
+	"arrow-up-circled": ArrowUpCircle,
+	"arrow-up-left": ArrowUpLeft,
+	"arrow-up-left-circle": ArrowUpLeftCircle,
+	"arrow-up-left-circle-solid": ArrowUpLeftCircleSolid,
+	"arrow-up-left-square": ArrowUpLeftSquare,
+	"arrow-up-left-square-solid": ArrowUpLeftSquareSolid,
+	"arrow-up-right": ArrowUpRight,
+	"arrow-up-right-circle": ArrowUpRightCircle,
+	"arrow-up-right-circle-solid": ArrowUpRightCircleSolid,
+	"arrow-up-right-square": ArrowUpRightSquare,
+	"arrow-up-right-square-solid": ArrowUpRightSquareSolid,
+	"arrow-up-tag": ArrowUpTag,
+	"arrows-up-from-line": ArrowsUpFromLine,
+	"asana": Asana,
+	"asterisk": Asterisk,
+	"at-sign": AtSign,
+	"at-sign-circle": AtSignCircle,
+	"atom": Atom,
+	"attachment": Attachment,
+	"augmented-reality": AugmentedReality,
+	"auto-flash": AutoFlash,
+	"avi-format": AviFormat,
+	"axes": Axes,
+	"backward-15-seconds": Backward15Seconds,
+	"badge-check": BadgeCheck,
+	"bag": Bag,
+	"balcony": Balcony,
+	"bank": Bank,
+	"barcode": Barcode,
+	"basket-ball": Basketball,
+	"basket-ball-alt": Basketball,
+	"basketball": Basketball,
+	"basketball-alt": Basketball,
+	"basketball-field": BasketballField,
+	"bathroom": Bathroom,
+	"bathroom-solid": BathroomSolid,
+	"battery-25": Battery25,
+	"battery-50": Battery50,
+	"battery-75": Battery75,
+	"battery-charging": BatteryCharging,
+	"battery-empty": BatteryEmpty,
+	"battery-full": BatteryFull,
+	"battery-indicator": BatteryIndicator,
+	"battery-slash": BatterySlash,
+	"battery-warning": BatteryWarning,
+	"bbq": Bbq,
+	"beach-bag": BeachBag,
+	"beach-bag-big": BeachBagBig,
+	"bed": Bed,
+	"bed-ready": BedReady,
+	"behance": Behance,
+	"behance-squared": BehanceTag,
+	"behance-tag": BehanceTag,
+	"bell": Bell,
+	"bell-notification": BellNotification,
+	"bell-notification-solid": BellNotificationSolid,
+	"bell-off": BellOff,
+	"bicycle": Bicycle,
+	"bin": Bin,
+	"bin-add": BinPlusIn,
+	"bin-full": BinFull,
+	"bin-half": BinHalf,
+	"bin-minus": BinMinusIn,
+	"bin-minus-in": BinMinusIn,
+	"bin-plus-in": BinPlusIn,
+	"binocular": Binocular,
+	"birthday-cake": BirthdayCake,
+	"bishop": Bishop,
+	"bitbucket": Bitbucket,
+	"bitcoin-circle": BitcoinCircle,
+	"bitcoin-circle-solid": BitcoinCircleSolid,
+	"bitcoin-rotate-out": BitcoinRotateOut,
+	"bluetooth": Bluetooth,
+	"bluetooth-rounded": BluetoothTag,
+	"bluetooth-tag": BluetoothTag,
+	"bluetooth-tag-solid": BluetoothTagSolid,
+	"bold": Bold,
+	"bold-square": BoldSquare,
+	"bold-square-outline": BoldSquare,
+	"bold-square-solid": BoldSquareSolid,
+	"bonfire": Bonfire,
+	"book": Book,
+	"book-lock": BookLock,
+	"book-solid": BookSolid,
+	"book-stack": BookStack,
+	"bookmark": Bookmark,
+	"bookmark-book": BookmarkBook,
+	"bookmark-circle": BookmarkCircle,
+	"bookmark-circle-solid": BookmarkCircleSolid,
+	"bookmark-circled": BookmarkCircle,
+	"bookmark-empty": Bookmark,
+	"bookmark-solid": BookmarkSolid,
+	"border-bl": BorderBl,
+	"border-bottom": BorderBottom,
+	"border-br": BorderBr,
+	"border-inner": BorderInner,
+	"border-left": BorderLeft,
+	"border-out": BorderOut,
+	"border-right": BorderRight,
+	"border-tl": BorderTl,
+	"border-top": BorderTop,
+	"border-tr": BorderTr,
+	"bounce-left": BounceLeft,
+	"bounce-right": BounceRight,
+	"bowling-ball": BowlingBall,
+	"box": Box,
+	"box-3d-center": Box3dCenter,
+	"box-3d-point": Box3dPoint,
+	"box-3d-three-points": Box3dThreePoints,
+	"box-iso": BoxIso,
+	"boxing-glove": BoxingGlove,
+	"brain": Brain,
+	"brain-electricity": BrainElectricity,
+	"brain-research": BrainResearch,
+	"brain-warning": BrainWarning,
+	"bread-slice": BreadSlice,
+	"bridge-3d": Bridge3d,
+	"bridge-surface": BridgeSurface,
+	"bright-crown": BrightCrown,
+	"bright-star": BrightStar,
+	"brightness": Brightness,
+	"brightness-window": BrightnessWindow,
+	"bubble-download": BubbleDownload,
+	"bubble-error": BubbleXmark,
+	"bubble-income": BubbleIncome,
+	"bubble-outcome": BubbleOutcome,
+	"bubble-search": BubbleSearch,
+	"bubble-search-solid": BubbleSearchSolid,
+	"bubble-star": BubbleStar,
+	"bubble-upload": BubbleUpload,
+	"bubble-warning": BubbleWarning,
+	"bubble-xmark": BubbleXmark,
+	"bubble-xmark-solid": BubbleXmarkSolid,
+	"building": Building,
+	"bus": Bus,
+	"bus-green": BusGreen,
+	"bus-outline": Bus,
+	"bus-stop": BusStop,
+	"c-square": CSquare,
+	"cable-rounded": CableTag,
+	"cable-tag": CableTag,
+	"cable-tag-solid": CableTagSolid,
+	"calculator": Calculator,
+	"calendar": Calendar,
+	"calendar-arrow-down": CalendarArrowDown,
+	"calendar-arrow-down-solid": CalendarArrowDownSolid,
+	"calendar-arrow-up": CalendarArrowUp,
+	"calendar-arrow-up-solid": CalendarArrowUpSolid,
+	"calendar-check": CalendarCheck,
+	"calendar-check-solid": CalendarCheckSolid,
+	"calendar-minus": CalendarMinus,
+	"calendar-minus-solid": CalendarMinusSolid,
+	"calendar-plus": CalendarPlus,
+	"calendar-plus-solid": CalendarPlusSolid,
+	"calendar-rotate": CalendarRotate,
+	"calendar-rotate-solid": CalendarRotateSolid,
+	"calendar-xmark": CalendarXmark,
+	"calendar-xmark-solid": CalendarXmarkSolid,
+	"camera": Camera,
+	"camera-solid": CameraSolid,
+	"cancel": Xmark,
+	"candlestick-chart": CandlestickChart,
+	"car": Car,
+	"car-outline": Car,
+	"carbon": CSquare,
+	"card-issue": CardNoAccess,
+	"card-lock": CardLock,
+	"card-locked": CardLock,
+	"card-no-access": CardNoAccess,
+	"card-reader": CardReader,
+	"card-security": CardShield,
+	"card-shield": CardShield,
+	"card-wallet": CardWallet,
+	"cart": Cart,
+	"cart-alt": CartAlt,
+	"cart-minus": CartMinus,
+	"cart-plus": CartPlus,
+	"cash": Cash,
+	"cash-solid": CashSolid,
+	"cell-2x2": Cell2x2,
+	"cellar": Cellar,
+	"center-align": CenterAlign,
+	"center-align-solid": CenterAlignSolid,
+	"chat-add": ChatPlusIn,
+	"chat-bubble": ChatBubble,
+	"chat-bubble-check": ChatBubbleCheck,
+	"chat-bubble-check-1": ChatBubbleCheck,
+	"chat-bubble-check-solid": ChatBubbleCheckSolid,
+	"chat-bubble-empty": ChatBubbleEmpty,
+	"chat-bubble-empty-solid": ChatBubbleEmptySolid,
+	"chat-bubble-error": ChatBubbleXmark,
+	"chat-bubble-question": ChatBubbleQuestion,
+	"chat-bubble-question-solid": ChatBubbleQuestionSolid,
+	"chat-bubble-solid": ChatBubbleSolid,
+	"chat-bubble-translate": ChatBubbleTranslate,
+	"chat-bubble-translate-solid": ChatBubbleTranslateSolid,
+	"chat-bubble-warning": ChatBubbleWarning,
+	"chat-bubble-warning-solid": ChatBubbleWarningSolid,
+	"chat-bubble-xmark": ChatBubbleXmark,
+	"chat-bubble-xmark-solid": ChatBubbleXmarkSolid,
+	"chat-lines": ChatLines,
+	"chat-lines-solid": ChatLinesSolid,
+	"chat-minus-in": ChatMinusIn,
+	"chat-minus-in-solid": ChatMinusInSolid,
+	"chat-plus-in": ChatPlusIn,
+	"chat-plus-in-solid": ChatPlusInSolid,
+	"chat-remove": ChatMinusIn,
+	"check": Check,
+	"check-circle": CheckCircle,
+	"check-circle-solid": CheckCircleSolid,
+	"check-circled-outline": CheckCircle,
+	"check-square": CheckSquare,
+	"check-square-solid": CheckSquareSolid,
+	"check-window": WindowCheck,
+	"chocolate": Chocolate,
+	"chromecast": Chromecast,
+	"chromecast-active": ChromecastActive,
+	"church": Church,
+	"church-alt": ChurchSide,
+	"church-side": ChurchSide,
+	"cigarette-slash": CigaretteSlash,
+	"cinema-old": CinemaOld,
+	"circle": Circle,
+	"circle-spark": CircleSpark,
+	"city": City,
+	"clean-water": DropletCheck,
+	"clipboard-check": ClipboardCheck,
+	"clock": Clock,
+	"clock-outline": Clock,
+	"clock-rotate-right": ClockRotateRight,
+	"clock-solid": ClockSolid,
+	"closed-captions": ClosedCaptionsTag,
+	"closed-captions-tag": ClosedCaptionsTag,
+	"closed-captions-tag-solid": ClosedCaptionsTagSolid,
+	"closet": Closet,
+	"cloud": Cloud,
+	"cloud-book-alt": CloudBookmark,
+	"cloud-bookmark": CloudBookmark,
+	"cloud-check": CloudCheck,
+	"cloud-desync": CloudDesync,
+	"cloud-download": CloudDownload,
+	"cloud-error": CloudXmark,
+	"cloud-square": CloudSquare,
+	"cloud-square-solid": CloudSquareSolid,
+	"cloud-sunny": CloudSunny,
+	"cloud-sync": CloudSync,
+	"cloud-upload": CloudUpload,
+	"cloud-xmark": CloudXmark,
+	"clutery": Cutlery,
+	"code": Code,
+	"code-brackets": CodeBrackets,
+	"code-brackets-square": CodeBracketsSquare,
+	"codepen": Codepen,
+	"coffee-cup": CoffeeCup,
+	"coin": DollarCircle,
+	"coin-slash": CoinSlash,
+	"coins": Coins,
+	"coins-swap": CoinsSwap,
+	"collage-frame": CollageFrame,
+	"collapse": Collapse,
+	"color-filter": ColorFilter,
+	"color-picker": ColorPicker,
+	"color-picker-empty": ColorPickerEmpty,
+	"color-wheel": ColorWheel,
+	"combine": Combine,
+	"commodity": Commodity,
+	"community": Community,
+	"comp-align-bottom": CompAlignBottom,
+	"comp-align-bottom-solid": CompAlignBottomSolid,
+	"comp-align-left": CompAlignLeft,
+	"comp-align-left-solid": CompAlignLeftSolid,
+	"comp-align-right": CompAlignRight,
+	"comp-align-right-solid": CompAlignRightSolid,
+	"comp-align-top": CompAlignTop,
+	"comp-align-top-solid": CompAlignTopSolid,
+	"compact-disc": CompactDisc,
+	"compass": Compass,
+	"component": Component,
+	"component-solid": ComponentSolid,
+	"compress": Compress,
+	"compress-lines": CompressLines,
+	"computer": Computer,
+	"constrained-surface": ConstrainedSurface,
+	"consumable": Consumable,
+	"contactless": Contactless,
+	"control-slider": ControlSlider,
+	"cookie": Cookie,
+	"cooling": CoolingSquare,
+	"cooling-square": CoolingSquare,
+	"cooling-square-solid": CoolingSquareSolid,
+	"copy": Copy,
+	"copyright": Copyright,
+	"corner-bottom-left": CornerBottomLeft,
+	"corner-bottom-right": CornerBottomRight,
+	"corner-top-left": CornerTopLeft,
+	"corner-top-right": CornerTopRight,
+	"cpu": Cpu,
+	"cpu-warning": CpuWarning,
+	"cracked-egg": CrackedEgg,
+	"creative-commons": CreativeCommons,
+	"credit-card": CreditCard,
+	"credit-card-2": CreditCard2,
+	"credit-card-slash": CreditCardSlash,
+	"credit-card-solid": CreditCardSolid,
+	"credit-cards": CreditCards,
+	"crib": Crib,
+	"crop": Crop,
+	"crop-rotate-bl": CropRotateBl,
+	"crop-rotate-br": CropRotateBr,
+	"crop-rotate-tl": CropRotateTl,
+	"crop-rotate-tr": CropRotateTr,
+	"crown": Crown,
+	"crown-circle": CrownCircle,
+	"css3": Css3,
+	"cube": Cube,
+	"cube-bandage": CubeBandage,
+	"cube-cut-with-curve": CubeCutWithCurve,
+	"cube-dots": CubeDots,
+	"cube-dots-solid": CubeDotsSolid,
+	"cube-hole": CubeHole,
+	"cube-replace-face": CubeReplaceFace,
+	"cube-scan": CubeScan,
+	"cube-scan-solid": CubeScanSolid,
+	"cursor-pointer": CursorPointer,
+	"curve-array": CurveArray,
+	"cut": Cut,
+	"cut-alt": CutAlt,
+	"cut-solid-with-curve": CubeCutWithCurve,
+	"cutlery": Cutlery,
+	"cycling": Cycling,
+	"cylinder": Cylinder,
+	"dash-flag": DashFlag,
+	"dashboard": Dashboard,
+	"dashboard-dots": DashboardDots,
+	"dashboard-speed": DashboardSpeed,
+	"data-transfer-both": DataTransferBoth,
+	"data-transfer-check": DataTransferCheck,
+	"data-transfer-down": DataTransferDown,
+	"data-transfer-up": DataTransferUp,
+	"data-transfer-warning": DataTransferWarning,
+	"database": Database,
+	"database-backup": DatabaseBackup,
+	"database-check": DatabaseCheck,
+	"database-check-solid": DatabaseCheckSolid,
+	"database-export": DatabaseExport,
+	"database-monitor": DatabaseMonitor,
+	"database-restore": DatabaseRestore,
+	"database-rounded": DatabaseTag,
+	"database-script": DatabaseScript,
+	"database-script-minus": DatabaseScriptMinus,
+	"database-script-plus": DatabaseScriptPlus,
+	"database-search": DatabaseSearch,
+	"database-settings": DatabaseSettings,
+	"database-solid": DatabaseSolid,
+	"database-star": DatabaseStar,
+	"database-stats": DatabaseStats,
+	"database-tag": DatabaseTag,
+	"database-tag-solid": DatabaseTagSolid,
+	"database-warning": DatabaseWarning,
+	"database-xmark": DatabaseXmark,
+	"database-xmark-solid": DatabaseXmarkSolid,
+	"db": Database,
+	"db-check": DatabaseCheck,
+	"db-error": DatabaseXmark,
+	"db-search": DatabaseSearch,
+	"db-star": DbStar,
+	"db-warning": DatabaseWarning,
+	"de-compress": DeCompress,
+	"delete-circle": XmarkCircle,
+	"delete-circled-outline": XmarkCircle,
+	"delivery": Delivery,
+	"delivery-truck": DeliveryTruck,
+	"depth": Depth,
+	"design-nib": DesignNib,
+	"design-nib-solid": DesignNibSolid,
+	"design-pencil": DesignPencil,
+	"desk": Desk,
+	"dev-mode-laptop": LaptopDevMode,
+	"dev-mode-phone": MobileDevMode,
+	"developer": Developer,
+	"dew-point": DewPoint,
+	"dialpad": Dialpad,
+	"diameter": Diameter,
+	"diameter-solid": DiameterSolid,
+	"dice-five": DiceFive,
+	"dice-four": DiceFour,
+	"dice-one": DiceOne,
+	"dice-six": DiceSix,
+	"dice-three": DiceThree,
+	"dice-two": DiceTwo,
+	"dimmer-switch": DimmerSwitch,
+	"director-chair": DirectorChair,
+	"discord": Discord,
+	"dishwasher": Dishwasher,
+	"display-4k": Display4k,
+	"divide": Divide,
+	"divide-selection-1": SplitArea,
+	"divide-selection-2": SplitSquareDashed,
+	"divide-three": DivideThree,
+	"dna": Dna,
+	"dns": Dns,
+	"doc-magnifying-glass": DocMagnifyingGlass,
+	"doc-magnifying-glass-in": DocMagnifyingGlassIn,
+	"doc-search": DocMagnifyingGlass,
+	"doc-search-alt": DocMagnifyingGlassIn,
+	"doc-star": DocStar,
+	"doc-star-alt": DocStarIn,
+	"doc-star-in": DocStarIn,
+	"dogecoin-circle": DogecoinCircle,
+	"dogecoin-circle-solid": DogecoinCircleSolid,
+	"dogecoin-rotate-out": DogecoinRotateOut,
+	"dollar": Dollar,
+	"dollar-circle": DollarCircle,
+	"dollar-circle-solid": DollarCircleSolid,
+	"domotic-issue": DomoticWarning,
+	"domotic-warning": DomoticWarning,
+	"donate": Donate,
+	"dot-arrow-down": DotArrowDown,
+	"dot-arrow-left": DotArrowLeft,
+	"dot-arrow-right": DotArrowRight,
+	"dot-arrow-up": DotArrowUp,
+	"double-check": DoubleCheck,
+	"down-round-arrow": ArrowDownTag,
+	"download": Download,
+	"download-circle": DownloadCircle,
+	"download-circle-solid": DownloadCircleSolid,
+	"download-circled-outline": DownloadCircle,
+	"download-data-window": DownloadDataWindow,
+	"download-square": DownloadSquare,
+	"download-square-outline": DownloadSquare,
+	"download-square-solid": DownloadSquareSolid,
+	"drag": Drag,
+	"drag-hand-gesture": DragHandGesture,
+	"drawer": Drawer,
+	"dribbble": Dribbble,
+	"drone": Drone,
+	"drone-charge-full": DroneChargeFull,
+	"drone-charge-half": DroneChargeHalf,
+	"drone-charge-low": DroneChargeLow,
+	"drone-check": DroneCheck,
+	"drone-error": DroneXmark,
+	"drone-landing": DroneLanding,
+	"drone-refresh": DroneRefresh,
+	"drone-take-off": DroneTakeOff,
+	"drone-xmark": DroneXmark,
+	"droplet": Droplet,
+	"droplet-check": DropletCheck,
+	"droplet-half": DropletHalf,
+	"droplet-snow-flake": DropletSnowFlakeIn,
+	"droplet-snow-flake-in": DropletSnowFlakeIn,
+	"droplet-snow-flake-in-solid": DropletSnowFlakeInSolid,
+	"droplet-solid": DropletSolid,
+	"ease-curve-control-points": EaseCurveControlPoints,
+	"ease-in": EaseIn,
+	"ease-in-control-point": EaseInControlPoint,
+	"ease-in-out": EaseInOut,
+	"ease-out": EaseOut,
+	"ease-out-control-point": EaseOutControlPoint,
+	"ecology-book": EcologyBook,
+	"edit": Edit,
+	"edit-pencil": EditPencil,
+	"egg": Egg,
+	"eject": Eject,
+	"electronics-chip": ElectronicsChip,
+	"electronics-transister": ElectronicsTransistor,
+	"electronics-transistor": ElectronicsTransistor,
+	"elevator": Elevator,
+	"ellipse-3d": Ellipse3d,
+	"ellipse-3d-three-points": Ellipse3dThreePoints,
+	"emoji": Emoji,
+	"emoji-ball": EmojiBall,
+	"emoji-blink-left": EmojiBlinkLeft,
+	"emoji-blink-right": EmojiBlinkRight,
+	"emoji-look-bottom": EmojiLookDown,
+	"emoji-look-down": EmojiLookDown,
+	"emoji-look-left": EmojiLookLeft,
+	"emoji-look-right": EmojiLookRight,
+	"emoji-look-top": EmojiLookUp,
+	"emoji-look-up": EmojiLookUp,
+	"emoji-puzzled": EmojiPuzzled,
+	"emoji-quite": EmojiQuite,
+	"emoji-really": EmojiReally,
+	"emoji-sad": EmojiSad,
+	"emoji-satisfied": EmojiSatisfied,
+	"emoji-sing-left": EmojiSingLeft,
+	"emoji-sing-left-note": EmojiSingLeftNote,
+	"emoji-sing-right": EmojiSingRight,
+	"emoji-sing-right-note": EmojiSingRightNote,
+	"emoji-surprise": EmojiSurprise,
+	"emoji-surprise-alt": EmojiSurpriseAlt,
+	"emoji-talking-angry": EmojiTalkingAngry,
+	"emoji-talking-happy": EmojiTalkingHappy,
+	"emoji-think-left": EmojiThinkLeft,
+	"emoji-think-right": EmojiThinkRight,
+	"empty-page": EmptyPage,
+	"energy-usage-window": EnergyUsageWindow,
+	"enlarge": Enlarge,
+	"enlarge-round-arrow": ArrowEnlargeTag,
+	"erase": Erase,
+	"erase-solid": EraseSolid,
+	"error-window": WindowXmark,
+	"ethereum-circle": EthereumCircle,
+	"ethereum-circle-solid": EthereumCircleSolid,
+	"ethereum-rotate-out": EthereumRotateOut,
+	"euro": Euro,
+	"euro-square": EuroSquare,
+	"euro-square-solid": EuroSquareSolid,
+	"ev-charge": EvCharge,
+	"ev-charge-alt": EvChargeAlt,
+	"ev-plug": EvPlug,
+	"ev-plug-charging": EvPlugCharging,
+	"ev-plug-error": EvPlugXmark,
+	"ev-plug-xmark": EvPlugXmark,
+	"ev-rounded": EvTag,
+	"ev-station": EvStation,
+	"ev-tag": EvTag,
+	"exclude": Exclude,
+	"expand": Expand,
+	"expand-lines": ExpandLines,
+	"extrude": Extrude,
+	"eye": Eye,
+	"eye-alt": Eye,
+	"eye-close": EyeClosed,
+	"eye-closed": EyeClosed,
+	"eye-empty": EyeEmpty,
+	"eye-off": EyeOff,
+	"eye-solid": EyeSolid,
+	"f-square": FSquare,
+	"face-3d-draft": Face3dDraft,
+	"face-id": FaceId,
+	"facebook": Facebook,
+	"facebook-squared": FacebookTag,
+	"facebook-tag": FacebookTag,
+	"facetime": Facetime,
+	"facetime-solid": FacetimeSolid,
+	"farm": Farm,
+	"fast-arrow-bottom": FastArrowDown,
+	"fast-arrow-down": FastArrowDown,
+	"fast-arrow-down-box": FastArrowDownSquare,
+	"fast-arrow-down-square": FastArrowDownSquare,
+	"fast-arrow-left": FastArrowLeft,
+	"fast-arrow-left-box": FastArrowLeftSquare,
+	"fast-arrow-left-square": FastArrowLeftSquare,
+	"fast-arrow-right": FastArrowRight,
+	"fast-arrow-right-box": FastArrowRightSquare,
+	"fast-arrow-right-square": FastArrowRightSquare,
+	"fast-arrow-top": FastArrowUp,
+	"fast-arrow-up": FastArrowUp,
+	"fast-arrow-up-box": FastArrowUpSquare,
+	"fast-arrow-up-square": FastArrowUpSquare,
+	"fast-bottom-circle": FastDownCircle,
+	"fast-down-circle": FastDownCircle,
+	"fast-left-circle": FastLeftCircle,
+	"fast-right-circle": FastRightCircle,
+	"fast-top-circle": FastUpCircle,
+	"fast-up-circle": FastUpCircle,
+	"favourite-book": FavouriteBook,
+	"favourite-window": FavouriteWindow,
+	"female": Female,
+	"figma": Figma,
+	"file-not-found": FileNotFound,
+	"fill-color": FillColor,
+	"fill-color-solid": FillColorSolid,
+	"fillet-3d": Fillet3d,
+	"filter": Filter,
+	"filter-alt": FilterAlt,
+	"filter-list": FilterList,
+	"filter-list-circle": FilterListCircle,
+	"filter-solid": FilterSolid,
+	"finder": Finder,
+	"finger-print-window": FingerprintWindow,
+	"fingerprint": Fingerprint,
+	"fingerprint-check-circle": FingerprintCheckCircle,
+	"fingerprint-circle": FingerprintCircle,
+	"fingerprint-circled": FingerprintCircle,
+	"fingerprint-circled-error": FingerprintXmarkCircle,
+	"fingerprint-circled-lock": FingerprintLockCircle,
+	"fingerprint-circled-ok": FingerprintCheckCircle,
+	"fingerprint-error-circle": FingerprintXmarkCircle,
+	"fingerprint-lock-circle": FingerprintLockCircle,
+	"fingerprint-phone": MobileFingerprint,
+	"fingerprint-scan": FingerprintScan,
+	"fingerprint-square": FingerprintSquare,
+	"fingerprint-squared": FingerprintSquare,
+	"fingerprint-window": FingerprintWindow,
+	"fingerprint-xmark-circle": FingerprintXmarkCircle,
+	"fire-flame": FireFlame,
+	"fish": Fish,
+	"fishing": Fishing,
+	"flare": Flare,
+	"flash": Flash,
+	"flash-off": FlashOff,
+	"flash-solid": FlashSolid,
+	"flask": Flask,
+	"flask-solid": FlaskSolid,
+	"flip": Flip,
+	"flip-reverse": FlipReverse,
+	"floppy-disk": FloppyDisk,
+	"floppy-disk-arrow-in": FloppyDiskArrowIn,
+	"floppy-disk-arrow-out": FloppyDiskArrowOut,
+	"flower": Flower,
+	"fluorine": FSquare,
+	"fog": Fog,
+	"folder": Folder,
+	"folder-alert": FolderWarning,
+	"folder-minus": FolderMinus,
+	"folder-plus": FolderPlus,
+	"folder-settings": FolderSettings,
+	"folder-warning": FolderWarning,
+	"font-question": FontQuestion,
+	"font-size": TextArrowsUpDown,
+	"football": Football,
+	"football-ball": FootballBall,
+	"forward": Forward,
+	"forward-15-seconds": Forward15Seconds,
+	"forward-message": ForwardMessage,
+	"forward-outline": Forward,
+	"forward-solid": ForwardSolid,
+	"frame": Frame,
+	"frame-alt": FrameAlt,
+	"frame-alt-empty": FrameAltEmpty,
+	"frame-minus-in": FrameMinusIn,
+	"frame-plus-in": FramePlusIn,
+	"frame-select": FrameSelect,
+	"frame-simple": FrameSimple,
+	"frame-tool": FrameTool,
+	"frame-tool-solid": FrameToolSolid,
+	"fridge": Fridge,
+	"fx": Fx,
+	"fx-rounded": FxTag,
+	"fx-tag": FxTag,
+	"fx-tag-solid": FxTagSolid,
+	"gamepad": Gamepad,
+	"garage": Garage,
+	"gas": Gas,
+	"gas-tank": GasTank,
+	"gas-tank-drop": GasTankDroplet,
+	"gas-tank-droplet": GasTankDroplet,
+	"gif-format": GifFormat,
+	"gift": Gift,
+	"git": Git,
+	"git-branch": GitBranch,
+	"git-cherry-pick-commit": GitCherryPickCommit,
+	"git-command": SlashSquare,
+	"git-commit": GitCommit,
+	"git-compare": GitCompare,
+	"git-fork": GitFork,
+	"git-merge": GitMerge,
+	"git-pull-request": GitPullRequest,
+	"git-pull-request-closed": GitPullRequestClosed,
+	"git-solid": GitSolid,
+	"github": Github,
+	"github-circle": GithubCircle,
+	"github-outline": GithubCircle,
+	"gitlab-full": GitlabFull,
+	"glass-empty": GlassEmpty,
+	"glass-fragile": GlassFragile,
+	"glass-half": GlassHalf,
+	"glass-half-alt": GlassHalfAlt,
+	"glasses": Glasses,
+	"globe": Globe,
+	"golf": Golf,
+	"google": Google,
+	"google-circle": GoogleCircle,
+	"google-circled": GoogleCircle,
+	"google-docs": GoogleDocs,
+	"google-drive": GoogleDrive,
+	"google-drive-check": GoogleDriveCheck,
+	"google-drive-sync": GoogleDriveSync,
+	"google-drive-warning": GoogleDriveWarning,
+	"google-home": GoogleHome,
+	"google-one": GoogleOne,
+	"gps": Gps,
+	"graduation-cap": GraduationCap,
+	"graduation-cap-solid": GraduationCapSolid,
+	"graph-down": GraphDown,
+	"graph-up": GraphUp,
+	"green-bus": BusGreen,
+	"green-truck": TruckGreen,
+	"green-vehicle": VehicleGreen,
+	"grid-add": GridPlus,
+	"grid-minus": GridMinus,
+	"grid-plus": GridPlus,
+	"grid-remove": GridXmark,
+	"grid-xmark": GridXmark,
+	"group": Group,
+	"gym": Gym,
+	"h-square": HSquare,
+	"half-cookie": HalfCookie,
+	"half-moon": HalfMoon,
+	"hammer": Hammer,
+	"hand-brake": HandBrake,
+	"hand-card": HandCard,
+	"hand-cash": HandCash,
+	"hand-contactless": HandContactless,
+	"handbag": Handbag,
+	"hard-drive": HardDrive,
+	"hashtag": Hashtag,
+	"hat": Hat,
+	"hd": Hd,
+	"hd-display": HdDisplay,
+	"hd-display-solid": HdDisplaySolid,
+	"hdr": Hdr,
+	"headset": Headset,
+	"headset-bolt": HeadsetBolt,
+	"headset-bolt-solid": HeadsetBoltSolid,
+	"headset-charge": HeadsetBolt,
+	"headset-help": HeadsetHelp,
+	"headset-issue": HeadsetWarning,
+	"headset-solid": HeadsetSolid,
+	"headset-warning": HeadsetWarning,
+	"headset-warning-solid": HeadsetWarningSolid,
+	"health-shield": HealthShield,
+	"healthcare": Healthcare,
+	"heart": Heart,
+	"heart-arrow-down": HeartArrowDown,
+	"heart-solid": HeartSolid,
+	"heating": HeatingSquare,
+	"heating-square": HeatingSquare,
+	"heating-square-solid": HeatingSquareSolid,
+	"heavy-rain": HeavyRain,
+	"help-circle": HelpCircle,
+	"help-circle-solid": HelpCircleSolid,
+	"help-square": HelpSquare,
+	"help-square-solid": HelpSquareSolid,
+	"heptagon": Heptagon,
+	"her-slips": Slips,
+	"hesa-warning-outline": WarningHexagon,
+	"hexagon": Hexagon,
+	"hexagon-alt": HexagonAlt,
+	"hexagon-dice": HexagonDice,
+	"hexagon-plus": HexagonPlus,
+	"high-priority": PriorityHigh,
+	"historic-shield": HistoricShield,
+	"historic-shield-alt": HistoricShieldAlt,
+	"home": Home,
+	"home-alt": HomeAlt,
+	"home-alt-slim": HomeAltSlim,
+	"home-alt-slim-horiz": HomeAltSlimHoriz,
+	"home-hospital": HomeHospital,
+	"home-sale": HomeSale,
+	"home-secure": HomeSecure,
+	"home-shield": HomeShield,
+	"home-simple": HomeSimple,
+	"home-simple-door": HomeSimpleDoor,
+	"home-table": HomeTable,
+	"home-temperature-in": HomeTemperatureIn,
+	"home-temperature-out": HomeTemperatureOut,
+	"home-user": HomeUser,
+	"horiz-distribution-left": HorizDistributionLeft,
+	"horiz-distribution-left-solid": HorizDistributionLeftSolid,
+	"horiz-distribution-right": HorizDistributionRight,
+	"horiz-distribution-right-solid": HorizDistributionRightSolid,
+	"horizontal-merge": HorizontalMerge,
+	"horizontal-split": HorizontalSplit,
+	"hospital": Hospital,
+	"hospital-circle": HospitalCircle,
+	"hospital-circle-solid": HospitalCircleSolid,
+	"hospital-sign": HospitalCircle,
+	"hot-air-balloon": HotAirBalloon,
+	"hourglass": Hourglass,
+	"house-rooms": HouseRooms,
+	"html5": Html5,
+	"hydrogen": HSquare,
+	"ice-cream": IceCream,
+	"ice-cream-solid": IceCreamSolid,
+	"ice-cream-solid-solid": IceCreamSolid,
+	"iconoir": Iconoir,
+	"import": Import,
+	"inclination": Inclination,
+	"industry": Industry,
+	"infinite": Infinite,
+	"info-circle": InfoCircle,
+	"info-circle-solid": InfoCircleSolid,
+	"info-empty": InfoCircle,
+	"input-field": InputField,
+	"input-output": InputOutput,
+	"input-search": InputSearch,
+	"instagram": Instagram,
+	"internet": Internet,
+	"intersect": Intersect,
+	"intersect-alt": IntersectAlt,
+	"ios-settings": IosSettings,
+	"ip-address": IpAddressTag,
+	"ip-address-tag": IpAddressTag,
+	"iris-scan": IrisScan,
+	"italic": Italic,
+	"italic-square": ItalicSquare,
+	"italic-square-outline": ItalicSquare,
+	"italic-square-solid": ItalicSquareSolid,
+	"jellyfish": Jellyfish,
+	"journal": Journal,
+	"journal-page": JournalPage,
+	"jpeg-format": JpegFormat,
+	"jpg-format": JpgFormat,
+	"kanban-board": KanbanBoard,
+	"key": Key,
+	"key-alt": Key,
+	"key-alt-back": KeyBack,
+	"key-alt-minus": KeyMinus,
+	"key-alt-plus": KeyPlus,
+	"key-alt-remove": KeyXmark,
+	"key-back": KeyBack,
+	"key-command": KeyCommand,
+	"key-minus": KeyMinus,
+	"key-plus": KeyPlus,
+	"key-xmark": KeyXmark,
+	"keyframe": Keyframe,
+	"keyframe-align-center": KeyframeAlignCenter,
+	"keyframe-align-center-solid": KeyframeAlignCenterSolid,
+	"keyframe-align-horizontal": KeyframeAlignHorizontal,
+	"keyframe-align-horizontal-1": KeyframeAlignVertical,
+	"keyframe-align-horizontal-solid": KeyframeAlignHorizontalSolid,
+	"keyframe-align-vertical": KeyframeAlignVertical,
+	"keyframe-align-vertical-solid": KeyframeAlignVerticalSolid,
+	"keyframe-minus": KeyframeMinus,
+	"keyframe-minus-in": KeyframeMinusIn,
+	"keyframe-minus-in-solid": KeyframeMinusInSolid,
+	"keyframe-minus-solid": KeyframeMinusSolid,
+	"keyframe-plus": KeyframePlus,
+	"keyframe-plus-in": KeyframePlusIn,
+	"keyframe-plus-in-solid": KeyframePlusInSolid,
+	"keyframe-plus-solid": KeyframePlusSolid,
+	"keyframe-position": KeyframePosition,
+	"keyframe-position-solid": KeyframePositionSolid,
+	"keyframe-solid": KeyframeSolid,
+	"keyframes": Keyframes,
+	"keyframes-couple": KeyframesCouple,
+	"keyframes-couple-solid": KeyframesCoupleSolid,
+	"keyframes-minus": KeyframesMinus,
+	"keyframes-plus": KeyframesPlus,
+	"keyframes-solid": KeyframesSolid,
+	"label": Label,
+	"label-outline": Label,
+	"label-solid": LabelSolid,
+	"lamp": Lamp,
+	"language": Language,
+	"laptop": Laptop,
+	"laptop-charging": LaptopCharging,
+	"laptop-dev-mode": LaptopDevMode,
+	"laptop-fix": LaptopFix,
+	"laptop-issue": LaptopWarning,
+	"laptop-warning": LaptopWarning,
+	"large-suitcase": Suitcase,
+	"layout-left": LayoutLeft,
+	"layout-right": LayoutRight,
+	"leaderboard": Leaderboard,
+	"leaderboard-star": LeaderboardStar,
+	"leaf": Leaf,
+	"learning": Learning,
+	"left-round-arrow": ArrowLeftTag,
+	"lens": Lens,
+	"lens-plus": LensPlus,
+	"lifebelt": Lifebelt,
+	"light-bulb": LightBulb,
+	"light-bulb-off": LightBulbOff,
+	"light-bulb-on": LightBulbOn,
+	"line-space": LineSpace,
+	"linear": Linear,
+	"link": Link,
+	"link-slash": LinkSlash,
+	"link-xmark": LinkXmark,
+	"linkedin": Linkedin,
+	"linux": Linux,
+	"list": List,
+	"list-select": ListSelect,
+	"litecoin-circle": LitecoinCircle,
+	"litecoin-circle-solid": LitecoinCircleSolid,
+	"litecoin-rotate-out": LitecoinRotateOut,
+	"load-action-floppy": FloppyDiskArrowOut,
+	"lock": Lock,
+	"lock-key": LockSquare,
+	"lock-slash": LockSlash,
+	"lock-square": LockSquare,
+	"locked-book": BookLock,
+	"locked-window": WindowLock,
+	"loft-3d": Loft3d,
+	"log-denied": LogNoAccess,
+	"log-in": LogIn,
+	"log-no-access": LogNoAccess,
+	"log-out": LogOut,
+	"long-arrow-down-left": LongArrowDownLeft,
+	"long-arrow-down-right": LongArrowDownRight,
+	"long-arrow-left-down": LongArrowLeftDown,
+	"long-arrow-left-up": LongArrowLeftUp,
+	"long-arrow-right-down": LongArrowRightDown,
+	"long-arrow-right-up": LongArrowRightUp,
+	"long-arrow-right-up-1": LongArrowRightUp1,
+	"long-arrow-up-left": LongArrowUpLeft,
+	"long-arrow-up-right": LongArrowUpRight,
+	"lot-of-cash": LotOfCash,
+	"lullaby": Lullaby,
+	"mac-control-key": MacControlKey,
+	"mac-dock": MacDock,
+	"mac-option-key": MacOptionKey,
+	"mac-os-window": MacOsWindow,
+	"magic-wand": MagicWand,
+	"magnet": Magnet,
+	"magnet-energy": MagnetEnergy,
+	"magnet-solid": MagnetSolid,
+	"mail": Mail,
+	"mail-in": MailIn,
+	"mail-in-solid": MailInSolid,
+	"mail-open": MailOpen,
+	"mail-open-solid": MailOpenSolid,
+	"mail-opened": MailOpen,
+	"mail-out": MailOut,
+	"mail-out-solid": MailOutSolid,
+	"mail-solid": MailSolid,
+	"male": Male,
+	"map": Map,
+	"map-issue": MapXmark,
+	"map-pin": MapPin,
+	"map-pin-minus": MapPinMinus,
+	"map-pin-plus": MapPinPlus,
+	"map-pin-xmark": MapPinXmark,
+	"map-xmark": MapXmark,
+	"maps-arrow": MapsArrow,
+	"maps-arrow-diagonal": MapsArrowDiagonal,
+	"maps-arrow-issue": MapsArrowXmark,
+	"maps-arrow-xmark": MapsArrowXmark,
+	"maps-go-straight": MapsGoStraight,
+	"maps-turn-back": MapsTurnBack,
+	"maps-turn-left": MapsTurnLeft,
+	"maps-turn-right": MapsTurnRight,
+	"mask-square": MaskSquare,
+	"mastercard-card": MastercardCard,
+	"mastodon": Mastodon,
+	"math-book": MathBook,
+	"maximize": Maximize,
+	"medal": Medal,
+	"medal-1st": Medal1st,
+	"medal-1st-solid": Medal1stSolid,
+	"medal-solid": MedalSolid,
+	"media-image": MediaImage,
+	"media-image-folder": MediaImageFolder,
+	"media-image-list": MediaImageList,
+	"media-image-plus": MediaImagePlus,
+	"media-image-xmark": MediaImageXmark,
+	"media-video": MediaVideo,
+	"media-video-folder": MediaVideoFolder,
+	"media-video-list": MediaVideoList,
+	"media-video-plus": MediaVideoPlus,
+	"media-video-xmark": MediaVideoXmark,
+	"medium": Medium,
+	"medium-priority": PriorityMedium,
+	"megaphone": Megaphone,
+	"menu": Menu,
+	"menu-scale": MenuScale,
+	"message": Message,
+	"message-alert": MessageAlert,
+	"message-alert-solid": MessageAlertSolid,
+	"message-solid": MessageSolid,
+	"message-text": MessageText,
+	"message-text-solid": MessageTextSolid,
+	"meter-arrow-down-right": MeterArrowDownRight,
+	"metro": Metro,
+	"mic": Microphone,
+	"mic-add": MicrophonePlus,
+	"mic-check": MicrophoneCheck,
+	"mic-mute": MicrophoneMute,
+	"mic-remove": MicrophoneMinus,
+	"mic-speaking": MicrophoneSpeaking,
+	"mic-warning": MicrophoneWarning,
+	"microphone": Microphone,
+	"microphone-check": MicrophoneCheck,
+	"microphone-check-solid": MicrophoneCheckSolid,
+	"microphone-minus": MicrophoneMinus,
+	"microphone-minus-solid": MicrophoneMinusSolid,
+	"microphone-mute": MicrophoneMute,
+	"microphone-mute-solid": MicrophoneMuteSolid,
+	"microphone-plus": MicrophonePlus,
+	"microphone-plus-solid": MicrophonePlusSolid,
+	"microphone-solid": MicrophoneSolid,
+	"microphone-speaking": MicrophoneSpeaking,
+	"microphone-speaking-solid": MicrophoneSpeakingSolid,
+	"microphone-warning": MicrophoneWarning,
+	"microphone-warning-solid": MicrophoneWarningSolid,
+	"microscope": Microscope,
+	"microscope-solid": MicroscopeSolid,
+	"minus": Minus,
+	"minus-1": Minus,
+	"minus-circle": MinusCircle,
+	"minus-circle-solid": MinusCircleSolid,
+	"minus-hexagon": MinusHexagon,
+	"minus-pin-alt": MapPinMinus,
+	"minus-square": MinusSquare,
+	"minus-square-dashed": MinusSquareDashed,
+	"minus-square-solid": MinusSquareSolid,
+	"mirror": Mirror,
+	"missing-font": FontQuestion,
+	"mobile-dev-mode": MobileDevMode,
+	"mobile-fingerprint": MobileFingerprint,
+	"mobile-voice": MobileVoice,
+	"modern-tv": ModernTv,
+	"modern-tv-4k": ModernTv4k,
+	"money-square": MoneySquare,
+	"money-square-solid": MoneySquareSolid,
+	"moon-sat": MoonSat,
+	"more-horiz": MoreHoriz,
+	"more-horiz-circle": MoreHorizCircle,
+	"more-horiz-circled-outline": MoreHorizCircle,
+	"more-vert": MoreVert,
+	"more-vert-circle": MoreVertCircle,
+	"more-vert-circled-outline": MoreVertCircle,
+	"motorcycle": Motorcycle,
+	"mouse-button-left": MouseButtonLeft,
+	"mouse-button-right": MouseButtonRight,
+	"mouse-scroll-wheel": MouseScrollWheel,
+	"move-down": DotArrowDown,
+	"move-left": DotArrowLeft,
+	"move-right": DotArrowRight,
+	"move-ruler": RulerArrows,
+	"move-up": DotArrowUp,
+	"movie": Movie,
+	"mpeg-format": MpegFormat,
+	"multi-bubble": MultiBubble,
+	"multi-bubble-solid": MultiBubbleSolid,
+	"multi-mac-os-window": MultiMacOsWindow,
+	"multi-window": MultiWindow,
+	"multiple-pages": MultiplePages,
+	"multiple-pages-add": MultiplePagesPlus,
+	"multiple-pages-delete": MultiplePagesXmark,
+	"multiple-pages-empty": MultiplePagesEmpty,
+	"multiple-pages-minus": MultiplePagesMinus,
+	"multiple-pages-plus": MultiplePagesPlus,
+	"multiple-pages-remove": MultiplePagesMinus,
+	"multiple-pages-xmark": MultiplePagesXmark,
+	"music-1": MusicDoubleNote,
+	"music-1-add": MusicDoubleNotePlus,
+	"music-2": MusicNote,
+	"music-2-add": MusicNotePlus,
+	"music-double-note": MusicDoubleNote,
+	"music-double-note-add": MusicDoubleNotePlus,
+	"music-double-note-plus": MusicDoubleNotePlus,
+	"music-note": MusicNote,
+	"music-note-add": MusicNotePlus,
+	"music-note-plus": MusicNotePlus,
+	"music-note-plus-solid": MusicNotePlusSolid,
+	"music-note-solid": MusicNoteSolid,
+	"n-square": NSquare,
+	"nav-arrow-down": NavArrowDown,
+	"nav-arrow-left": NavArrowLeft,
+	"nav-arrow-right": NavArrowRight,
+	"nav-arrow-up": NavArrowUp,
+	"navigator": Navigator,
+	"navigator-alt": NavigatorAlt,
+	"neighbourhood": Neighbourhood,
+	"network": Network,
+	"network-alt": NetworkReverse,
+	"network-left": NetworkLeft,
+	"network-left-solid": NetworkLeftSolid,
+	"network-reverse": NetworkReverse,
+	"network-reverse-solid": NetworkReverseSolid,
+	"network-right": NetworkRight,
+	"network-right-solid": NetworkRightSolid,
+	"network-solid": NetworkSolid,
+	"new-tab": NewTab,
+	"nintendo-switch": NintendoSwitch,
+	"nitrogen": NSquare,
+	"no-access-window": WindowNoAccess,
+	"no-battery": BatterySlash,
+	"no-coin": CoinSlash,
+	"no-credit-card": CreditCardSlash,
+	"no-link": LinkXmark,
+	"no-lock": LockSlash,
+	"no-smoking": CigaretteSlash,
+	"no-smoking-circle": NoSmokingCircle,
+	"no-smoking-circled": NoSmokingCircle,
+	"non-binary": NonBinary,
+	"notes": Notes,
+	"npm": Npm,
+	"npm-square": NpmSquare,
+	"number-0-square": Number0Square,
+	"number-0-square-solid": Number0SquareSolid,
+	"number-1-square": Number1Square,
+	"number-1-square-solid": Number1SquareSolid,
+	"number-2-square": Number2Square,
+	"number-2-square-solid": Number2SquareSolid,
+	"number-3-square": Number3Square,
+	"number-3-square-solid": Number3SquareSolid,
+	"number-4-square": Number4Square,
+	"number-4-square-solid": Number4SquareSolid,
+	"number-5-square": Number5Square,
+	"number-5-square-solid": Number5SquareSolid,
+	"number-6-square": Number6Square,
+	"number-6-square-solid": Number6SquareSolid,
+	"number-7-square": Number7Square,
+	"number-7-square-solid": Number7SquareSolid,
+	"number-8-square": Number8Square,
+	"number-8-square-solid": Number8SquareSolid,
+	"number-9-square": Number9Square,
+	"number-9-square-solid": Number9SquareSolid,
+	"numbered-list-left": NumberedListLeft,
+	"numbered-list-right": NumberedListRight,
+	"o-square": OSquare,
+	"octagon": Octagon,
+	"off-rounded": OffTag,
+	"off-tag": OffTag,
+	"oil-industry": OilIndustry,
+	"okrs": Okrs,
+	"on-rounded": OnTag,
+	"on-tag": OnTag,
+	"one-finger-select-hand-gesture": OneFingerSelectHandGesture,
+	"one-point-circle": OnePointCircle,
+	"open-book": OpenBook,
+	"open-in-browser": OpenInBrowser,
+	"open-in-window": OpenInWindow,
+	"open-new-window": OpenNewWindow,
+	"open-select-hand-gesture": OpenSelectHandGesture,
+	"open-vpn": OpenVpn,
+	"orange-half": OrangeHalf,
+	"orange-slice": OrangeSlice,
+	"orange-slice-alt": OrangeSliceAlt,
+	"organic-food": OrganicFood,
+	"organic-food-square": OrganicFoodSquare,
+	"organic-food-squared": OrganicFoodSquare,
+	"orthogonal-view": OrthogonalView,
+	"oxygen": OSquare,
+	"package": Package,
+	"package-lock": PackageLock,
+	"packages": Packages,
+	"pacman": Pacman,
+	"page": Page,
+	"page-down": PageDown,
+	"page-edit": PageEdit,
+	"page-flip": PageFlip,
+	"page-left": PageLeft,
+	"page-minus": PageMinus,
+	"page-minus-in": PageMinusIn,
+	"page-plus": PagePlus,
+	"page-plus-in": PagePlusIn,
+	"page-right": PageRight,
+	"page-search": PageSearch,
+	"page-star": PageStar,
+	"page-up": PageUp,
+	"palette": Palette,
+	"panorama-enlarge": PanoramaEnlarge,
+	"panorama-reduce": PanoramaReduce,
+	"pants": Pants,
+	"pants-alt": Pants,
+	"pants-pockets": PantsPockets,
+	"parking": Parking,
+	"password-check": PasswordCheck,
+	"password-cursor": PasswordCursor,
+	"password-error": PasswordXmark,
+	"password-pass": PasswordCheck,
+	"password-xmark": PasswordXmark,
+	"paste-clipboard": PasteClipboard,
+	"patch-holes-3d": CubeBandage,
+	"path-arrow": PathArrow,
+	"pause": Pause,
+	"pause-outline": Pause,
+	"pause-solid": PauseSolid,
+	"pause-window": PauseWindow,
+	"paypal": Paypal,
+	"pc-check": PcCheck,
+	"pc-firewall": PcFirewall,
+	"pc-mouse": PcMouse,
+	"pc-no-entry": PcNoEntry,
+	"pc-warning": PcWarning,
+	"peace-hand": PeaceHand,
+	"peerlist": Peerlist,
+	"pen-connect-bluetooth": PenConnectBluetooth,
+	"pen-connect-wifi": PenConnectWifi,
+	"pen-tablet": PenTablet,
+	"pen-tablet-connect-usb": PenTabletConnectUsb,
+	"pen-tablet-connect-wifi": PenTabletConnectWifi,
+	"pentagon": Pentagon,
+	"people-rounded": PeopleTag,
+	"people-tag": PeopleTag,
+	"percent-rotate-out": PercentRotateOut,
+	"percentage": Percentage,
+	"percentage-circle": PercentageCircle,
+	"percentage-circle-solid": PercentageCircleSolid,
+	"percentage-round": PercentageCircle,
+	"percentage-square": PercentageSquare,
+	"percentage-square-solid": PercentageSquareSolid,
+	"perspective-view": PerspectiveView,
+	"pharmacy-circled-cross": PharmacyCrossCircle,
+	"pharmacy-cross-circle": PharmacyCrossCircle,
+	"pharmacy-cross-square": PharmacyCrossTag,
+	"pharmacy-cross-tag": PharmacyCrossTag,
+	"pharmacy-squared-cross": PharmacyCrossTag,
+	"phone": Phone,
+	"phone-add": PhonePlus,
+	"phone-delete": PhoneXmark,
+	"phone-disabled": PhoneDisabled,
+	"phone-income": PhoneIncome,
+	"phone-income-solid": PhoneIncomeSolid,
+	"phone-minus": PhoneMinus,
+	"phone-minus-solid": PhoneMinusSolid,
+	"phone-outcome": PhoneOutcome,
+	"phone-outcome-solid": PhoneOutcomeSolid,
+	"phone-paused": PhonePaused,
+	"phone-paused-solid": PhonePausedSolid,
+	"phone-plus": PhonePlus,
+	"phone-plus-solid": PhonePlusSolid,
+	"phone-remove": PhoneMinus,
+	"phone-solid": PhoneSolid,
+	"phone-xmark": PhoneXmark,
+	"phone-xmark-solid": PhoneXmarkSolid,
+	"piggy-bank": PiggyBank,
+	"pillow": Pillow,
+	"pin": Pin,
+	"pin-alt": MapPin,
+	"pin-slash": PinSlash,
+	"pin-slash-solid": PinSlashSolid,
+	"pin-solid": PinSolid,
+	"pine-tree": PineTree,
+	"pinterest": Pinterest,
+	"pipe-3d": Pipe3d,
+	"pizza-slice": PizzaSlice,
+	"planet": Planet,
+	"planet-alt": PlanetAlt,
+	"planet-sat": PlanetSat,
+	"planet-solid": PlanetSolid,
+	"planimetry": Planimetry,
+	"play": Play,
+	"play-outline": Play,
+	"play-solid": PlaySolid,
+	"playlist": Playlist,
+	"playlist-add": PlaylistPlus,
+	"playlist-play": PlaylistPlay,
+	"playlist-plus": PlaylistPlus,
+	"playstation-gamepad": PlaystationGamepad,
+	"plug-type-a": PlugTypeA,
+	"plug-type-c": PlugTypeC,
+	"plug-type-g": PlugTypeG,
+	"plug-type-l": PlugTypeL,
+	"plus": Plus,
+	"plus-circle": PlusCircle,
+	"plus-circle-solid": PlusCircleSolid,
+	"plus-square": PlusSquare,
+	"plus-square-dashed": PlusSquareDashed,
+	"plus-square-solid": PlusSquareSolid,
+	"png-format": PngFormat,
+	"pocket": Pocket,
+	"podcast": Podcast,
+	"podcast-solid": PodcastSolid,
+	"pokeball": Pokeball,
+	"polar-sh": PolarSh,
+	"position": Position,
+	"position-align": PositionAlign,
+	"post": Post,
+	"post-solid": PostSolid,
+	"potion": Potion,
+	"pound": Pound,
+	"precision-tool": PrecisionTool,
+	"presentation": Presentation,
+	"presentation-solid": PresentationSolid,
+	"printer": Printer,
+	"printer-alt": Printer,
+	"printing-page": PrintingPage,
+	"priority-down": PriorityDown,
+	"priority-down-solid": PriorityDownSolid,
+	"priority-high": PriorityHigh,
+	"priority-high-solid": PriorityHighSolid,
+	"priority-medium": PriorityMedium,
+	"priority-medium-solid": PriorityMediumSolid,
+	"priority-up": PriorityUp,
+	"priority-up-solid": PriorityUpSolid,
+	"privacy-policy": PrivacyPolicy,
+	"private-wifi": PrivateWifi,
+	"profile-circle": ProfileCircle,
+	"profile-circled": ProfileCircle,
+	"prohibition": Prohibition,
+	"project-curve-3d": ProjectCurve3d,
+	"puzzle": Puzzle,
+	"qr-code": QrCode,
+	"question-mark": QuestionMark,
+	"question-mark-circle": HelpCircle,
+	"question-square-outline": HelpSquare,
+	"quote": Quote,
+	"quote-message": QuoteMessage,
+	"quote-message-solid": QuoteMessageSolid,
+	"quote-solid": QuoteSolid,
+	"radiation": Radiation,
+	"radiation-solid": RadiationSolid,
+	"radius": Radius,
+	"radius-solid": RadiusSolid,
+	"rain": Rain,
+	"raw-format": RawFormat,
+	"receive-dollars": ReceiveDollars,
+	"receive-euros": ReceiveEuros,
+	"receive-pounds": ReceivePounds,
+	"receive-yens": ReceiveYens,
+	"redo": Redo,
+	"redo-action": RedoAction,
+	"redo-circle": RedoCircle,
+	"redo-circle-solid": RedoCircleSolid,
+	"reduce": Reduce,
+	"reduce-round-arrow": ArrowReduceTag,
+	"refresh": Refresh,
+	"refresh-circle": RefreshCircle,
+	"refresh-circle-solid": RefreshCircleSolid,
+	"refresh-circular": RefreshCircle,
+	"refresh-double": RefreshDouble,
+	"reload-window": ReloadWindow,
+	"reminder-hand-gesture": ReminderHandGesture,
+	"remove-database-script": DatabaseScriptMinus,
+	"remove-empty": MinusCircle,
+	"remove-folder": FolderMinus,
+	"remove-frame": FrameMinusIn,
+	"remove-from-cart": CartMinus,
+	"remove-keyframe": KeyframeMinus,
+	"remove-keyframe-alt": KeyframeMinusIn,
+	"remove-keyframes": KeyframesMinus,
+	"remove-link": LinkSlash,
+	"remove-media-image": MediaImageXmark,
+	"remove-media-video": MediaVideoXmark,
+	"remove-page": PageMinusIn,
+	"remove-page-alt": PageMinus,
+	"remove-pin": PinSlash,
+	"remove-pin-alt": MapPinXmark,
+	"remove-selection": MinusSquareDashed,
+	"remove-square": XmarkSquare,
+	"remove-user": UserXmark,
+	"repeat": Repeat,
+	"repeat-once": RepeatOnce,
+	"reply": Reply,
+	"reply-to-message": ReplyToMessage,
+	"report-columns": ReportColumns,
+	"reports": Reports,
+	"reports-solid": ReportsSolid,
+	"repository": Repository,
+	"restart": Restart,
+	"rewind": Rewind,
+	"rewind-outline": Rewind,
+	"rewind-solid": RewindSolid,
+	"rhombus": Rhombus,
+	"rhombus-arrow-right": RhombusArrowRight,
+	"rhombus-arrow-right-solid": RhombusArrowRightSolid,
+	"rhombus-arrow-right-solid-solid": RhombusArrowRightSolid,
+	"right-round-arrow": ArrowRightTag,
+	"rings": Rings,
+	"rocket": Rocket,
+	"rook": Rook,
+	"rotate-camera-left": RotateCameraLeft,
+	"rotate-camera-right": RotateCameraRight,
+	"round-flask": RoundFlask,
+	"round-flask-solid": RoundFlaskSolid,
+	"rounded-mirror": RoundedMirror,
+	"rss-feed": RssFeed,
+	"rss-feed-squared": RssFeedTag,
+	"rss-feed-tag": RssFeedTag,
+	"rubik-cube": RubikCube,
+	"ruler": Ruler,
+	"ruler-add": RulerPlus,
+	"ruler-arrows": RulerArrows,
+	"ruler-combine": RulerCombine,
+	"ruler-minus": RulerMinus,
+	"ruler-plus": RulerPlus,
+	"ruler-remove": RulerMinus,
+	"running": Running,
+	"safari": Safari,
+	"safe": Safe,
+	"safe-arrow-left": SafeArrowLeft,
+	"safe-arrow-right": SafeArrowRight,
+	"safe-open": SafeOpen,
+	"sandals": Sandals,
+	"save-action-floppy": FloppyDiskArrowIn,
+	"save-floppy-disk": FloppyDisk,
+	"scale-frame-enlarge": ScaleFrameEnlarge,
+	"scale-frame-reduce": ScaleFrameReduce,
+	"scan-barcode": ScanBarcode,
+	"scan-qr-code": ScanQrCode,
+	"scanning": Scanning,
+	"scarf": Scarf,
+	"scissor": Scissor,
+	"scissor-alt": ScissorAlt,
+	"screenshot": Screenshot,
+	"sea-and-sun": SeaAndSun,
+	"sea-waves": SeaWaves,
+	"search": Search,
+	"search-engine": SearchEngine,
+	"search-font": TextMagnifyingGlass,
+	"search-window": SearchWindow,
+	"secure-window": SecureWindow,
+	"security-pass": SecurityPass,
+	"select-edge-3d": SelectEdge3d,
+	"select-face-3d": SelectFace3d,
+	"select-point-3d": SelectPoint3d,
+	"select-window": SelectWindow,
+	"selection": SquareDashed,
+	"selective-tool": SelectiveTool,
+	"send": Send,
+	"send-diagonal": SendDiagonal,
+	"send-diagonal-solid": SendDiagonalSolid,
+	"send-dollars": SendDollars,
+	"send-euros": SendEuros,
+	"send-mail": SendMail,
+	"send-mail-solid": SendMailSolid,
+	"send-pounds": SendPounds,
+	"send-solid": SendSolid,
+	"send-yens": SendYens,
+	"server": Server,
+	"server-connection": ServerConnection,
+	"server-connection-solid": ServerConnectionSolid,
+	"server-solid": ServerSolid,
+	"settings": Settings,
+	"settings-cloud": CloudSquare,
+	"settings-profiles": SettingsProfiles,
+	"share-android": ShareAndroid,
+	"share-android-solid": ShareAndroidSolid,
+	"share-ios": ShareIos,
+	"shield": Shield,
+	"shield-add": ShieldPlusIn,
+	"shield-alert": ShieldAlert,
+	"shield-alt": ShieldAlt,
+	"shield-broken": ShieldBroken,
+	"shield-check": ShieldCheck,
+	"shield-cross": ShieldXmark,
+	"shield-download": ShieldDownload,
+	"shield-eye": ShieldEye,
+	"shield-loading": ShieldLoading,
+	"shield-minus": ShieldMinus,
+	"shield-plus-in": ShieldPlusIn,
+	"shield-question": ShieldQuestion,
+	"shield-search": ShieldSearch,
+	"shield-upload": ShieldUpload,
+	"shield-xmark": ShieldXmark,
+	"shirt": Shirt,
+	"shirt-tank-top": ShirtTankTop,
+	"shop": Shop,
+	"shop-alt": ShopFourTiles,
+	"shop-four-tiles": ShopFourTiles,
+	"shop-four-tiles-window": ShopFourTilesWindow,
+	"shop-window": ShopWindow,
+	"shopping-bag": ShoppingBag,
+	"shopping-bag-add": ShoppingBagPlus,
+	"shopping-bag-alt": ShoppingBagPocket,
+	"shopping-bag-arrow-down": ShoppingBagArrowDown,
+	"shopping-bag-arrow-up": ShoppingBagArrowUp,
+	"shopping-bag-check": ShoppingBagCheck,
+	"shopping-bag-issue": ShoppingBagWarning,
+	"shopping-bag-minus": ShoppingBagMinus,
+	"shopping-bag-plus": ShoppingBagPlus,
+	"shopping-bag-pocket": ShoppingBagPocket,
+	"shopping-bag-remove": ShoppingBagMinus,
+	"shopping-bag-warning": ShoppingBagWarning,
+	"shopping-code": ShoppingCode,
+	"shopping-code-check": ShoppingCodeCheck,
+	"shopping-code-error": ShoppingCodeXmark,
+	"shopping-code-xmark": ShoppingCodeXmark,
+	"short-pants": ShortPants,
+	"short-pants-alt": ShortPants,
+	"short-pants-pockets": ShortPantsPockets,
+	"shortcut": ShortcutSquare,
+	"shortcut-square": ShortcutSquare,
+	"shuffle": Shuffle,
+	"sidebar-collapse": SidebarCollapse,
+	"sidebar-expand": SidebarExpand,
+	"sigma-function": SigmaFunction,
+	"simple-cart": SimpleCart,
+	"sine-wave": SineWave,
+	"single-tap-gesture": SingleTapGesture,
+	"skateboard": Skateboard,
+	"skateboarding": Skateboarding,
+	"skip-next": SkipNext,
+	"skip-next-outline": SkipNext,
+	"skip-next-solid": SkipNextSolid,
+	"skip-prev": SkipPrev,
+	"skip-prev-outline": SkipPrev,
+	"skip-prev-solid": SkipPrevSolid,
+	"slash": Slash,
+	"slash-square": SlashSquare,
+	"sleeper-chair": SleeperChair,
+	"slips": Slips,
+	"small-lamp": SmallLamp,
+	"small-lamp-alt": SmallLampAlt,
+	"small-shop": ShopWindow,
+	"small-shop-alt": Shop,
+	"smartphone-device": SmartphoneDevice,
+	"smoking": Smoking,
+	"snapchat": Snapchat,
+	"snow": Snow,
+	"snow-flake": SnowFlake,
+	"soap": Soap,
+	"soccer-ball": SoccerBall,
+	"sofa": Sofa,
+	"soil": Soil,
+	"soil-alt": SoilAlt,
+	"sort": Sort,
+	"sort-down": SortDown,
+	"sort-up": SortUp,
+	"sound-high": SoundHigh,
+	"sound-high-solid": SoundHighSolid,
+	"sound-low": SoundLow,
+	"sound-low-solid": SoundLowSolid,
+	"sound-min": SoundMin,
+	"sound-min-solid": SoundMinSolid,
+	"sound-off": SoundOff,
+	"sound-off-solid": SoundOffSolid,
+	"spades": Spades,
+	"spark": Spark,
+	"spark-solid": SparkSolid,
+	"sparks": Sparks,
+	"sparks-solid": SparksSolid,
+	"sphere": Sphere,
+	"spiral": Spiral,
+	"split-area": SplitArea,
+	"split-square-dashed": SplitSquareDashed,
+	"spock-hand-gesture": SpockHandGesture,
+	"spotify": Spotify,
+	"square": Square,
+	"square-3d-corner-to-corner": Square3dCornerToCorner,
+	"square-3d-from-center": Square3dFromCenter,
+	"square-3d-three-points": Square3dThreePoints,
+	"square-cursor": SquareCursor,
+	"square-cursor-solid": SquareCursorSolid,
+	"square-dashed": SquareDashed,
+	"square-wave": SquareWave,
+	"stackoverflow": Stackoverflow,
+	"star": Star,
+	"star-dashed": StarDashed,
+	"star-half-dashed": StarHalfDashed,
+	"star-outline": Star,
+	"star-solid": StarSolid,
+	"stat-down": StatDown,
+	"stat-up": StatUp,
+	"stats-down-square": StatsDownSquare,
+	"stats-down-square-solid": StatsDownSquareSolid,
+	"stats-report": StatsReport,
+	"stats-square-down": StatsDownSquare,
+	"stats-square-up": StatsUpSquare,
+	"stats-up-square": StatsUpSquare,
+	"stats-up-square-solid": StatsUpSquareSolid,
+	"strategy": Strategy,
+	"stretching": Stretching,
+	"strikethrough": Strikethrough,
+	"stroller": Stroller,
+	"style-border": StyleBorder,
+	"style-border-solid": StyleBorderSolid,
+	"submit-document": SubmitDocument,
+	"substract": Substract,
+	"suggestion": Suggestion,
+	"suitcase": Suitcase,
+	"sun-light": SunLight,
+	"svg-format": SvgFormat,
+	"sweep-3d": Sweep3d,
+	"swimming": Swimming,
+	"swipe-down-gesture": SwipeDownGesture,
+	"swipe-left-gesture": SwipeLeftGesture,
+	"swipe-right-gesture": SwipeRightGesture,
+	"swipe-two-fingers-down-gesture": SwipeTwoFingersDownGesture,
+	"swipe-two-fingers-left-gesture": SwipeTwoFingersLeftGesture,
+	"swipe-two-fingers-right-gesture": SwipeTwoFingersRightGesture,
+	"swipe-two-fingers-up-gesture": SwipeTwoFingersUpGesture,
+	"swipe-up-gesture": SwipeUpGesture,
+	"switch-off": SwitchOff,
+	"switch-off-outline": SwitchOff,
+	"switch-on": SwitchOn,
+	"switch-on-outline": SwitchOn,
+	"system-restart": SystemRestart,
+	"system-shut": SystemShut,
+	"t-shirt": Shirt,
+	"table": Table,
+	"table-2-columns": Table2Columns,
+	"table-rows": TableRows,
+	"task-list": TaskList,
+	"telegram": Telegram,
+	"telegram-circle": TelegramCircle,
+	"telegram-circled": TelegramCircle,
+	"temperature-down": TemperatureDown,
+	"temperature-high": TemperatureHigh,
+	"temperature-low": TemperatureLow,
+	"temperature-up": TemperatureUp,
+	"tennis-ball": TennisBall,
+	"tennis-ball-alt": TennisBallAlt,
+	"terminal": Terminal,
+	"terminal-outline": TerminalTag,
+	"terminal-simple": Terminal,
+	"terminal-tag": TerminalTag,
+	"test-tube": TestTube,
+	"test-tube-solid": TestTubeSolid,
+	"text": Text,
+	"text-alt": TextSquare,
+	"text-arrows-up-down": TextArrowsUpDown,
+	"text-box": TextBox,
+	"text-magnifying-glass": TextMagnifyingGlass,
+	"text-size": TextSize,
+	"text-square": TextSquare,
+	"text-square-solid": TextSquareSolid,
+	"threads": Threads,
+	"three-points-circle": ThreePointsCircle,
+	"three-stars": ThreeStars,
+	"three-stars-solid": ThreeStarsSolid,
+	"thumbs-down": ThumbsDown,
+	"thumbs-up": ThumbsUp,
+	"thunderstorm": Thunderstorm,
+	"tif-format": TifFormat,
+	"tiff-format": TiffFormat,
+	"tiktok": Tiktok,
+	"time-zone": TimeZone,
+	"timer": Timer,
+	"timer-off": TimerOff,
+	"timer-solid": TimerSolid,
+	"tools": Tools,
+	"tournament": Tournament,
+	"tower": Tower,
+	"tower-check": TowerCheck,
+	"tower-no-access": TowerNoAccess,
+	"tower-warning": TowerWarning,
+	"trademark": Trademark,
+	"train": Train,
+	"train-outline": Train,
+	"tram": Tram,
+	"transition-bottom": TransitionDown,
+	"transition-down": TransitionDown,
+	"transition-down-solid": TransitionDownSolid,
+	"transition-left": TransitionLeft,
+	"transition-left-solid": TransitionLeftSolid,
+	"transition-right": TransitionRight,
+	"transition-right-solid": TransitionRightSolid,
+	"transition-top": TransitionUp,
+	"transition-up": TransitionUp,
+	"transition-up-solid": TransitionUpSolid,
+	"translate": Translate,
+	"trash": Trash,
+	"trash-solid": TrashSolid,
+	"treadmill": Treadmill,
+	"tree": Tree,
+	"trekking": Trekking,
+	"trello": Trello,
+	"triangle": Triangle,
+	"triangle-flag": TriangleFlag,
+	"triangle-flag-circle": TriangleFlagCircle,
+	"triangle-flag-full": TriangleFlagTwoStripes,
+	"triangle-flag-two-stripes": TriangleFlagTwoStripes,
+	"trophy": Trophy,
+	"truck": Truck,
+	"truck-green": TruckGreen,
+	"truck-length": TruckLength,
+	"tunnel": Tunnel,
+	"tv": Tv,
+	"tv-fix": TvFix,
+	"tv-issue": TvWarning,
+	"tv-warning": TvWarning,
+	"twitter": Twitter,
+	"twitter-verified-badge": BadgeCheck,
+	"two-points-circle": TwoPointsCircle,
+	"two-seater-sofa": TwoSeaterSofa,
+	"type": Type,
+	"u-turn-arrow-left": UTurnArrowLeft,
+	"u-turn-arrow-right": UTurnArrowRight,
+	"umbrella": Umbrella,
+	"umbrella-full": Umbrella,
+	"underline": Underline,
+	"underline-square": UnderlineSquare,
+	"underline-square-outline": UnderlineSquare,
+	"underline-square-solid": UnderlineSquareSolid,
+	"undo": Undo,
+	"undo-action": UndoAction,
+	"undo-circle": UndoCircle,
+	"undo-circle-solid": UndoCircleSolid,
+	"union": Union,
+	"union-alt": UnionAlt,
+	"union-horiz-alt": UnionHorizAlt,
+	"unity": Unity,
+	"unity-5": Unity5,
+	"unjoin-3d": Unjoin3d,
+	"up-round-arrow": ArrowUpTag,
+	"upload": Upload,
+	"upload-data-window": UploadDataWindow,
+	"upload-square": UploadSquare,
+	"upload-square-outline": UploadSquare,
+	"upload-square-solid": UploadSquareSolid,
+	"usb": Usb,
+	"usb-solid": UsbSolid,
+	"user": User,
+	"user-badge-check": UserBadgeCheck,
+	"user-bag": UserBag,
+	"user-cart": UserCart,
+	"user-circle": UserCircle,
+	"user-circle-alt": UserCircle,
+	"user-crown": UserCrown,
+	"user-love": UserLove,
+	"user-plus": UserPlus,
+	"user-scan": UserScan,
+	"user-square": UserSquare,
+	"user-square-alt": UserSquare,
+	"user-star": UserStar,
+	"user-xmark": UserXmark,
+	"vegan": Vegan,
+	"vegan-circle": VeganCircle,
+	"vegan-rounded": VeganCircle,
+	"vegan-square": VeganSquare,
+	"vegan-squared": VeganSquare,
+	"vehicle-green": VehicleGreen,
+	"verified-badge": VerifiedBadge,
+	"verified-user": UserBadgeCheck,
+	"vertical-merge": VerticalMerge,
+	"vertical-split": VerticalSplit,
+	"vials": Vials,
+	"vials-solid": VialsSolid,
+	"video-camera": VideoCamera,
+	"video-camera-off": VideoCameraOff,
+	"video-projector": VideoProjector,
+	"view-360": View360,
+	"view-columns-2": ViewColumns2,
+	"view-columns-3": ViewColumns3,
+	"view-grid": ViewGrid,
+	"view-structure-down": ViewStructureDown,
+	"view-structure-up": ViewStructureUp,
+	"voice": Voice,
+	"voice-check": VoiceCheck,
+	"voice-circle": VoiceCircle,
+	"voice-circled": VoiceCircle,
+	"voice-circled-lock": VoiceLockCircle,
+	"voice-error": VoiceXmark,
+	"voice-lock-circle": VoiceLockCircle,
+	"voice-ok": VoiceCheck,
+	"voice-phone": MobileVoice,
+	"voice-scan": VoiceScan,
+	"voice-square": VoiceSquare,
+	"voice-squared": VoiceSquare,
+	"voice-xmark": VoiceXmark,
+	"vr-symbol": VrTag,
+	"vr-tag": VrTag,
+	"vue-js": VueJs,
+	"waist": Waist,
+	"walking": Walking,
+	"wallet": Wallet,
+	"wallet-solid": WalletSolid,
+	"warning-circle": WarningCircle,
+	"warning-circle-solid": WarningCircleSolid,
+	"warning-circled-outline": WarningCircle,
+	"warning-hexagon": WarningHexagon,
+	"warning-square": WarningSquare,
+	"warning-square-outline": WarningSquare,
+	"warning-square-solid": WarningSquareSolid,
+	"warning-triangle": WarningTriangle,
+	"warning-triangle-outline": WarningTriangle,
+	"warning-triangle-solid": WarningTriangleSolid,
+	"warning-window": WarningWindow,
+	"wash": Wash,
+	"washing-machine": WashingMachine,
+	"watering-soil": WateringSoil,
+	"web-window": WebWindow,
+	"web-window-close": WebWindowXmark,
+	"web-window-energy-consumption": WebWindowEnergyConsumption,
+	"web-window-energy-consumption-solid": WebWindowEnergyConsumptionSolid,
+	"web-window-solid": WebWindowSolid,
+	"web-window-xmark": WebWindowXmark,
+	"web-window-xmark-solid": WebWindowXmarkSolid,
+	"webp-format": WebpFormat,
+	"weight": Weight,
+	"weight-alt": WeightAlt,
+	"white-flag": WhiteFlag,
+	"white-flag-solid": WhiteFlagSolid,
+	"wifi": Wifi,
+	"wifi-error": WifiXmark,
+	"wifi-issue": WifiWarning,
+	"wifi-off": WifiOff,
+	"wifi-rounded": WifiTag,
+	"wifi-signal-none": WifiSignalNone,
+	"wifi-signal-none-solid": WifiSignalNoneSolid,
+	"wifi-tag": WifiTag,
+	"wifi-tag-solid": WifiTagSolid,
+	"wifi-warning": WifiWarning,
+	"wifi-warning-solid": WifiWarningSolid,
+	"wifi-xmark": WifiXmark,
+	"wind": Wind,
+	"window-check": WindowCheck,
+	"window-lock": WindowLock,
+	"window-no-access": WindowNoAccess,
+	"window-tabs": WindowTabs,
+	"window-tabs-solid": WindowTabsSolid,
+	"window-xmark": WindowXmark,
+	"windows": Windows,
+	"wolf": Wolf,
+	"wolf-solid": WolfSolid,
+	"women-t-shirt": ShirtTankTop,
+	"wrap-text": WrapText,
+	"wrench": Wrench,
+	"wristwatch": Wristwatch,
+	"www": Www,
+	"x": X,
+	"x-coordinate": XSquare,
+	"x-square": XSquare,
+	"xbox-a": XboxA,
+	"xbox-b": XboxB,
+	"xbox-x": XboxX,
+	"xbox-y": XboxY,
+	"xmark": Xmark,
+	"xmark-circle": XmarkCircle,
+	"xmark-circle-solid": XmarkCircleSolid,
+	"xmark-square": XmarkSquare,
+	"xmark-square-solid": XmarkSquareSolid,
+	"xray-view": XrayView,
+	"y-coordinate": YSquare,
+	"y-square": YSquare,
+	"yelp": Yelp,
+	"yen": Yen,
+	"yen-square": YenSquare,
+	"yen-square-solid": YenSquareSolid,
+	"yoga": Yoga,
+	"youtube": Youtube,
+	"z-coordinate": ZSquare,
+	"z-square": ZSquare,
+	"zoom-in": ZoomIn,
+	"zoom-out": ZoomOut,
+}
+
+var iconCategories = map[string][]string{
+	"3D Editor": {"arc-3d", "arc-3d-center-point", "box-3d-center", "box-3d-point", "box-3d-three-points", "bridge-3d", "bridge-surface", "constrained-surface", "cube", "cube-bandage", "cube-cut-with-curve", "cube-dots", "cube-dots-solid", "cube-hole", "cube-replace-face", "cube-scan", "cube-scan-solid", "curve-array", "cylinder", "ellipse-3d", "ellipse-3d-three-points", "extrude", "face-3d-draft", "fillet-3d", "loft-3d", "one-point-circle", "orthogonal-view", "perspective-view", "pipe-3d", "project-curve-3d", "select-edge-3d", "select-face-3d", "select-point-3d", "sigma-function", "sphere", "spiral", "square-3d-corner-to-corner", "square-3d-from-center", "square-3d-three-points", "sweep-3d", "three-points-circle", "two-points-circle", "unjoin-3d", "xray-view"},
+	"Actions": {"check", "check-circle", "check-circle-solid", "check-square", "check-square-solid", "clipboard-check", "double-check", "download", "download-circle", "download-circle-solid", "download-square", "download-square-solid", "erase", "erase-solid", "eye", "eye-closed", "eye-solid", "floppy-disk-arrow-in", "floppy-disk-arrow-out", "help-circle", "help-circle-solid", "help-square", "help-square-solid", "info-circle", "info-circle-solid", "line-space", "menu", "menu-scale", "minus", "minus-circle", "minus-circle-solid", "minus-square", "minus-square-solid", "open-in-browser", "open-in-window", "open-new-window", "paste-clipboard", "plus", "plus-circle", "plus-circle-solid", "plus-square", "plus-square-solid", "prohibition", "question-mark", "redo", "redo-action", "redo-circle", "redo-circle-solid", "refresh", "refresh-circle", "refresh-circle-solid", "refresh-double", "restart", "share-android", "share-android-solid", "share-ios", "trash", "trash-solid", "undo", "undo-action", "undo-circle", "undo-circle-solid", "upload", "upload-square", "upload-square-solid", "warning-circle", "warning-circle-solid", "warning-square", "warning-square-solid", "warning-triangle", "warning-triangle-solid", "wrap-text", "xmark", "xmark-circle", "xmark-circle-solid", "xmark-square", "xmark-square-solid"},
+	"Activities": {"archery", "arrow-archery", "basketball", "basketball-field", "birthday-cake", "bonfire", "book", "book-lock", "book-solid", "book-stack", "bookmark-book", "bowling-ball", "boxing-glove", "cigarette-slash", "cinema-old", "cycling", "favourite-book", "fire-flame", "fishing", "flower", "football", "football-ball", "golf", "graph-down", "graph-up", "gym", "hourglass", "leaderboard", "leaderboard-star", "math-book", "medal", "medal-1st", "medal-1st-solid", "medal-solid", "movie", "no-smoking-circle", "open-book", "palette", "percentage", "percentage-circle", "percentage-circle-solid", "percentage-square", "percentage-square-solid", "report-columns", "reports", "reports-solid", "rings", "running", "sea-and-sun", "sea-waves", "skateboard", "skateboarding", "smoking", "soccer-ball", "stat-down", "stat-up", "stretching", "swimming", "tennis-ball", "tennis-ball-alt", "treadmill", "trekking", "trophy", "waist", "walking", "yoga"},
+	"Animals": {"fish", "jellyfish", "wolf", "wolf-solid"},
+	"Animations": {"bounce-left", "bounce-right", "dot-arrow-down", "dot-arrow-left", "dot-arrow-right", "dot-arrow-up", "ease-curve-control-points", "ease-in", "ease-in-control-point", "ease-in-out", "ease-out", "ease-out-control-point", "keyframe", "keyframe-align-center", "keyframe-align-center-solid", "keyframe-align-horizontal", "keyframe-align-horizontal-solid", "keyframe-align-vertical", "keyframe-align-vertical-solid", "keyframe-minus", "keyframe-minus-in", "keyframe-minus-in-solid", "keyframe-minus-solid", "keyframe-plus", "keyframe-plus-in", "keyframe-plus-in-solid", "keyframe-plus-solid", "keyframe-position", "keyframe-position-solid", "keyframe-solid", "keyframes", "keyframes-couple", "keyframes-couple-solid", "keyframes-minus", "keyframes-plus", "keyframes-solid", "linear", "transition-down", "transition-down-solid", "transition-left", "transition-left-solid", "transition-right", "transition-right-solid", "transition-up", "transition-up-solid"},
+	"Audio": {"microphone", "microphone-check", "microphone-check-solid", "microphone-minus", "microphone-minus-solid", "microphone-mute", "microphone-mute-solid", "microphone-plus", "microphone-plus-solid", "microphone-solid", "microphone-speaking", "microphone-speaking-solid", "microphone-warning", "microphone-warning-solid", "sound-high", "sound-high-solid", "sound-low", "sound-low-solid", "sound-min", "sound-min-solid", "sound-off", "sound-off-solid"},
+	"Buildings": {"balcony", "bathroom", "bathroom-solid", "building", "cellar", "church", "church-side", "city", "elevator", "farm", "garage", "hospital", "house-rooms", "industry", "neighbourhood", "oil-industry", "planimetry", "shop", "shop-four-tiles", "shop-four-tiles-window", "shop-window", "tunnel"},
+	"Business": {"agile", "okrs", "presentation", "presentation-solid", "priority-down", "priority-down-solid", "priority-high", "priority-high-solid", "priority-medium", "priority-medium-solid", "priority-up", "priority-up-solid", "stats-down-square", "stats-down-square-solid", "stats-report", "stats-up-square", "stats-up-square-solid", "strategy"},
+	"Clothing": {"bag", "beach-bag", "glasses", "handbag", "hat", "pants", "pants-pockets", "sandals", "scarf", "shirt", "shirt-tank-top", "short-pants", "short-pants-pockets", "slips", "suitcase", "umbrella"},
+	"Cloud": {"cloud-bookmark", "cloud-check", "cloud-desync", "cloud-download", "cloud-square", "cloud-square-solid", "cloud-sync", "cloud-upload", "cloud-xmark", "google-drive", "google-drive-check", "google-drive-sync", "google-drive-warning", "google-one"},
+	"Communication": {"app-notification", "app-notification-solid", "arrow-email-forward", "at-sign", "at-sign-circle", "bell", "bell-notification", "bell-notification-solid", "bell-off", "bubble-download", "bubble-income", "bubble-outcome", "bubble-search", "bubble-search-solid", "bubble-star", "bubble-upload", "bubble-warning", "bubble-xmark", "bubble-xmark-solid", "chat-bubble", "chat-bubble-check", "chat-bubble-check-solid", "chat-bubble-empty", "chat-bubble-empty-solid", "chat-bubble-question", "chat-bubble-question-solid", "chat-bubble-solid", "chat-bubble-translate", "chat-bubble-translate-solid", "chat-bubble-warning", "chat-bubble-warning-solid", "chat-bubble-xmark", "chat-bubble-xmark-solid", "chat-lines", "chat-lines-solid", "chat-minus-in", "chat-minus-in-solid", "chat-plus-in", "chat-plus-in-solid", "facetime", "facetime-solid", "forward-message", "globe", "headset-help", "internet", "mail", "mail-in", "mail-in-solid", "mail-open", "mail-open-solid", "mail-out", "mail-out-solid", "mail-solid", "message", "message-alert", "message-alert-solid", "message-solid", "message-text", "message-text-solid", "multi-bubble", "multi-bubble-solid", "phone", "phone-disabled", "phone-income", "phone-income-solid", "phone-minus", "phone-minus-solid", "phone-outcome", "phone-outcome-solid", "phone-paused", "phone-paused-solid", "phone-plus", "phone-plus-solid", "phone-solid", "phone-xmark", "phone-xmark-solid", "podcast", "podcast-solid", "quote", "quote-message", "quote-message-solid", "quote-solid", "reply", "reply-to-message", "send", "send-diagonal", "send-diagonal-solid", "send-mail", "send-mail-solid", "send-solid", "time-zone", "www"},
+	"Connectivity": {"airplay", "airplay-solid", "antenna", "antenna-off", "antenna-signal", "antenna-signal-tag", "bluetooth", "bluetooth-tag", "bluetooth-tag-solid", "cable-tag", "cable-tag-solid", "data-transfer-both", "data-transfer-check", "data-transfer-down", "data-transfer-up", "data-transfer-warning", "dns", "network", "network-left", "network-left-solid", "network-reverse", "network-reverse-solid", "network-right", "network-right-solid", "network-solid", "plug-type-a", "plug-type-c", "plug-type-g", "plug-type-l", "private-wifi", "server", "server-connection", "server-connection-solid", "server-solid", "usb", "usb-solid", "wifi", "wifi-off", "wifi-signal-none", "wifi-signal-none-solid", "wifi-tag", "wifi-tag-solid", "wifi-warning", "wifi-warning-solid", "wifi-xmark"},
+	"Database": {"database", "database-backup", "database-check", "database-check-solid", "database-export", "database-monitor", "database-restore", "database-script", "database-script-minus", "database-script-plus", "database-search", "database-settings", "database-solid", "database-star", "database-stats", "database-tag", "database-tag-solid", "database-warning", "database-xmark", "database-xmark-solid"},
+	"Design Tools": {"adobe-after-effects", "adobe-after-effects-solid", "adobe-illustrator", "adobe-illustrator-solid", "adobe-indesign", "adobe-indesign-solid", "adobe-lightroom", "adobe-lightroom-solid", "adobe-photoshop", "adobe-photoshop-solid", "adobe-xd", "adobe-xd-solid", "align-bottom-box", "align-bottom-box-solid", "align-horizontal-centers", "align-horizontal-centers-solid", "align-horizontal-spacing", "align-horizontal-spacing-solid", "align-left-box", "align-left-box-solid", "align-right-box", "align-right-box-solid", "align-top-box", "align-top-box-solid", "align-vertical-centers", "align-vertical-centers-solid", "align-vertical-spacing", "align-vertical-spacing-solid", "axes", "border-bl", "border-bottom", "border-br", "border-inner", "border-left", "border-out", "border-right", "border-tl", "border-top", "border-tr", "center-align", "center-align-solid", "collage-frame", "color-filter", "color-picker", "color-wheel", "combine", "comp-align-bottom", "comp-align-bottom-solid", "comp-align-left", "comp-align-left-solid", "comp-align-right", "comp-align-right-solid", "comp-align-top", "comp-align-top-solid", "component", "component-solid", "copy", "crop", "crop-rotate-bl", "crop-rotate-br", "crop-rotate-tl", "crop-rotate-tr", "cut", "design-nib", "design-nib-solid", "design-pencil", "droplet", "droplet-half", "droplet-solid", "exclude", "figma", "fill-color", "fill-color-solid", "flip", "flip-reverse", "frame", "frame-alt", "frame-alt-empty", "frame-minus-in", "frame-plus-in", "frame-select", "frame-simple", "frame-tool", "frame-tool-solid", "horiz-distribution-left", "horiz-distribution-left-solid", "horiz-distribution-right", "horiz-distribution-right-solid", "intersect", "intersect-alt", "lens", "lens-plus", "magic-wand", "mask-square", "position-align", "precision-tool", "ruler", "ruler-arrows", "ruler-combine", "ruler-minus", "ruler-plus", "scale-frame-enlarge", "scale-frame-reduce", "selective-tool", "style-border", "style-border-solid", "substract", "union", "union-alt", "union-horiz-alt"},
+	"Development": {"apple-swift", "asana", "code", "code-brackets", "code-brackets-square", "codepen", "creative-commons", "css3", "developer", "electronics-chip", "electronics-transistor", "html5", "iconoir", "kanban-board", "laptop-dev-mode", "mobile-dev-mode", "npm", "npm-square", "polar-sh", "puzzle", "slash", "trello", "unity", "unity-5", "vue-js"},
+	"Devices": {"apple-imac-2021", "apple-imac-2021-side", "ar-tag", "chromecast", "chromecast-active", "computer", "display-4k", "floppy-disk", "hard-drive", "laptop", "laptop-charging", "laptop-fix", "laptop-warning", "megaphone", "modern-tv", "modern-tv-4k", "pen-connect-bluetooth", "pen-connect-wifi", "pen-tablet", "pen-tablet-connect-usb", "pen-tablet-connect-wifi", "printer", "printing-page", "smartphone-device", "tv", "tv-fix", "tv-warning", "video-projector", "vr-tag", "warning-hexagon", "wristwatch"},
+	"Docs": {"archive", "attachment", "doc-magnifying-glass", "doc-magnifying-glass-in", "doc-star", "doc-star-in", "empty-page", "folder", "folder-minus", "folder-plus", "folder-settings", "folder-warning", "google-docs", "journal", "journal-page", "multiple-pages", "multiple-pages-empty", "multiple-pages-minus", "multiple-pages-plus", "multiple-pages-xmark", "page", "page-edit", "page-minus", "page-minus-in", "page-plus", "page-plus-in", "page-search", "page-star", "privacy-policy", "submit-document"},
+	"Editor": {"align-center", "align-justify", "align-left", "align-right", "bold", "bold-square", "bold-square-solid", "compress-lines", "edit", "edit-pencil", "expand-lines", "font-question", "italic", "italic-square", "italic-square-solid", "list", "list-select", "numbered-list-left", "numbered-list-right", "scissor", "scissor-alt", "sort", "sort-down", "sort-up", "strikethrough", "task-list", "text", "text-arrows-up-down", "text-box", "text-magnifying-glass", "text-size", "text-square", "text-square-solid", "underline", "underline-square", "underline-square-solid"},
+	"Emojis": {"emoji", "emoji-ball", "emoji-blink-left", "emoji-blink-right", "emoji-look-down", "emoji-look-left", "emoji-look-right", "emoji-look-up", "emoji-puzzled", "emoji-quite", "emoji-really", "emoji-sad", "emoji-satisfied", "emoji-sing-left", "emoji-sing-left-note", "emoji-sing-right", "emoji-sing-right-note", "emoji-surprise", "emoji-surprise-alt", "emoji-talking-angry", "emoji-talking-happy", "emoji-think-left", "emoji-think-right"},
+	"Finance": {"apple-wallet", "bank", "bitcoin-circle", "bitcoin-circle-solid", "bitcoin-rotate-out", "candlestick-chart", "card-lock", "card-no-access", "card-reader", "card-shield", "card-wallet", "cash", "cash-solid", "coin-slash", "coins", "coins-swap", "commodity", "contactless", "credit-card", "credit-card-slash", "credit-card-solid", "credit-cards", "dogecoin-circle", "dogecoin-circle-solid", "dogecoin-rotate-out", "dollar", "dollar-circle", "dollar-circle-solid", "ethereum-circle", "ethereum-circle-solid", "ethereum-rotate-out", "euro", "euro-square", "euro-square-solid", "hand-card", "hand-cash", "hand-contactless", "litecoin-circle", "litecoin-circle-solid", "litecoin-rotate-out", "lot-of-cash", "mastercard-card", "money-square", "money-square-solid", "paypal", "percent-rotate-out", "piggy-bank", "pound", "receive-dollars", "receive-euros", "receive-pounds", "receive-yens", "safe", "safe-arrow-left", "safe-arrow-right", "safe-open", "send-dollars", "send-euros", "send-pounds", "send-yens", "wallet", "wallet-solid", "yen", "yen-square", "yen-square-solid"},
+	"Food": {"apple", "apple-half", "bbq", "bread-slice", "chocolate", "coffee-cup", "cracked-egg", "cutlery", "egg", "glass-empty", "glass-half", "glass-half-alt", "ice-cream", "ice-cream-solid", "orange-half", "orange-slice", "orange-slice-alt", "pizza-slice"},
+	"Gaming": {"arcade", "archery-match", "augmented-reality", "bishop", "bright-crown", "bright-star", "crown", "crown-circle", "dice-five", "dice-four", "dice-one", "dice-six", "dice-three", "dice-two", "gamepad", "hexagon-dice", "nintendo-switch", "pacman", "playstation-gamepad", "pokeball", "potion", "rook", "spades", "tournament", "xbox-a", "xbox-b", "xbox-x", "xbox-y"},
+	"Gestures": {"drag-hand-gesture", "one-finger-select-hand-gesture", "open-select-hand-gesture", "peace-hand", "reminder-hand-gesture", "single-tap-gesture", "spock-hand-gesture", "swipe-down-gesture", "swipe-left-gesture", "swipe-right-gesture", "swipe-two-fingers-down-gesture", "swipe-two-fingers-left-gesture", "swipe-two-fingers-right-gesture", "swipe-two-fingers-up-gesture", "swipe-up-gesture"},
+	"Git": {"bitbucket", "git", "git-branch", "git-cherry-pick-commit", "git-commit", "git-compare", "git-fork", "git-merge", "git-pull-request", "git-pull-request-closed", "git-solid", "github", "github-circle", "gitlab-full", "repository", "slash-square"},
+	"Health": {"donate", "female", "health-shield", "healthcare", "heart", "heart-solid", "home-hospital", "hospital-circle", "hospital-circle-solid", "male", "non-binary", "pharmacy-cross-circle", "pharmacy-cross-tag", "stroller", "weight", "weight-alt"},
+	"Home": {"air-conditioner", "bed", "bed-ready", "box", "box-iso", "closet", "crib", "desk", "dimmer-switch", "director-chair", "dishwasher", "domotic-warning", "drawer", "fridge", "google-home", "home", "home-alt", "home-alt-slim", "home-alt-slim-horiz", "home-sale", "home-secure", "home-shield", "home-simple", "home-simple-door", "home-table", "home-temperature-in", "home-temperature-out", "home-user", "lamp", "light-bulb", "light-bulb-off", "light-bulb-on", "mirror", "pillow", "rounded-mirror", "sleeper-chair", "small-lamp", "small-lamp-alt", "sofa", "two-seater-sofa", "wash", "washing-machine"},
+	"Identity": {"face-id", "fingerprint", "fingerprint-check-circle", "fingerprint-circle", "fingerprint-lock-circle", "fingerprint-scan", "fingerprint-square", "fingerprint-xmark-circle", "iris-scan", "mobile-fingerprint", "mobile-voice", "scanning", "user-scan", "voice", "voice-check", "voice-circle", "voice-lock-circle", "voice-scan", "voice-square", "voice-xmark"},
+	"Layout": {"cell-2x2", "corner-bottom-left", "corner-bottom-right", "corner-top-left", "corner-top-right", "grid-minus", "grid-plus", "grid-xmark", "layout-left", "layout-right", "table", "table-2-columns", "table-rows", "view-columns-2", "view-columns-3", "view-grid", "view-structure-down", "view-structure-up"},
+	"Maps": {"gps", "map", "map-pin", "map-pin-minus", "map-pin-plus", "map-pin-xmark", "map-xmark", "maps-arrow", "maps-arrow-diagonal", "maps-arrow-xmark", "maps-go-straight", "maps-turn-back", "maps-turn-left", "maps-turn-right", "meter-arrow-down-right", "navigator", "navigator-alt", "position", "rhombus-arrow-right", "rhombus-arrow-right-solid", "suggestion", "u-turn-arrow-left", "u-turn-arrow-right", "view-360"},
+	"Music": {"album", "album-carousel", "album-list", "album-open", "backward-15-seconds", "compact-disc", "forward", "forward-15-seconds", "forward-solid", "headset", "headset-bolt", "headset-bolt-solid", "headset-solid", "headset-warning", "headset-warning-solid", "lullaby", "music-double-note", "music-double-note-plus", "music-note", "music-note-plus", "music-note-plus-solid", "music-note-solid", "pause", "pause-solid", "play", "play-solid", "playlist", "playlist-play", "playlist-plus", "repeat", "repeat-once", "rewind", "rewind-solid", "shuffle", "skip-next", "skip-next-solid", "skip-prev", "skip-prev-solid", "spotify"},
+	"Nature": {"african-tree", "droplet-check", "ecology-book", "leaf", "organic-food", "organic-food-square", "pine-tree", "soil", "soil-alt", "tree", "vegan", "vegan-circle", "vegan-square", "watering-soil"},
+	"Navigation": {"arrow-down", "arrow-down-circle", "arrow-down-circle-solid", "arrow-down-left", "arrow-down-left-circle", "arrow-down-left-circle-solid", "arrow-down-left-square", "arrow-down-right", "arrow-down-right-circle", "arrow-down-right-circle-solid", "arrow-down-right-square", "arrow-down-right-square-solid", "arrow-down-tag", "arrow-enlarge-tag", "arrow-left", "arrow-left-circle", "arrow-left-circle-solid", "arrow-left-tag", "arrow-reduce-tag", "arrow-right", "arrow-right-circle", "arrow-right-circle-solid", "arrow-right-tag", "arrow-separate", "arrow-separate-vertical", "arrow-union", "arrow-union-vertical", "arrow-up", "arrow-up-circle", "arrow-up-circle-solid", "arrow-up-left", "arrow-up-left-circle", "arrow-up-left-circle-solid", "arrow-up-left-square", "arrow-up-left-square-solid", "arrow-up-right", "arrow-up-right-circle", "arrow-up-right-circle-solid", "arrow-up-right-square", "arrow-up-right-square-solid", "arrow-up-tag", "compass", "divide", "divide-three", "drag", "fast-arrow-down", "fast-arrow-down-square", "fast-arrow-left", "fast-arrow-left-square", "fast-arrow-right", "fast-arrow-right-square", "fast-arrow-up", "fast-arrow-up-square", "fast-down-circle", "fast-left-circle", "fast-right-circle", "fast-up-circle", "filter-list", "filter-list-circle", "horizontal-merge", "horizontal-split", "long-arrow-down-left", "long-arrow-down-right", "long-arrow-left-down", "long-arrow-left-up", "long-arrow-right-down", "long-arrow-right-up", "long-arrow-up-left", "long-arrow-up-right", "more-horiz", "more-horiz-circle", "more-vert", "more-vert-circle", "nav-arrow-down", "nav-arrow-left", "nav-arrow-right", "nav-arrow-up", "page-down", "page-left", "page-right", "page-up", "path-arrow", "shortcut-square", "sidebar-collapse", "sidebar-expand", "vertical-merge", "vertical-split"},
+	"Organization": {"area-search", "binocular", "bookmark", "bookmark-circle", "bookmark-circle-solid", "bookmark-solid", "filter", "filter-alt", "filter-solid", "input-search", "label", "label-solid", "pin", "pin-slash", "pin-slash-solid", "pin-solid", "search", "star", "star-dashed", "star-half-dashed", "star-solid", "three-stars", "three-stars-solid", "zoom-in", "zoom-out"},
+	"Other": {"activity", "alarm", "alarm-solid", "battery-indicator", "circle-spark", "clock", "clock-solid", "compress", "copyright", "dash-flag", "de-compress", "dialpad", "file-not-found", "gas", "gift", "half-moon", "import", "language", "lifebelt", "link", "link-slash", "link-xmark", "maximize", "minus-square-dashed", "notes", "number-0-square", "number-0-square-solid", "number-1-square", "number-1-square-solid", "number-2-square", "number-2-square-solid", "number-3-square", "number-3-square-solid", "number-4-square", "number-4-square-solid", "number-5-square", "number-5-square-solid", "number-6-square", "number-6-square-solid", "number-7-square", "number-7-square-solid", "number-8-square", "number-8-square-solid", "number-9-square", "number-9-square-solid", "page-flip", "plus-square-dashed", "qr-code", "scan-qr-code", "soap", "spark", "spark-solid", "sparks", "sparks-solid", "split-area", "split-square-dashed", "square-dashed", "timer", "timer-off", "timer-solid", "trademark", "translate", "triangle-flag", "triangle-flag-circle", "triangle-flag-two-stripes", "white-flag", "white-flag-solid"},
+	"Photos and Videos": {"auto-flash", "avi-format", "camera", "camera-solid", "closed-captions-tag", "closed-captions-tag-solid", "collapse", "enlarge", "expand", "flash", "flash-off", "flash-solid", "fx", "fx-tag", "fx-tag-solid", "gif-format", "hd", "hd-display", "hd-display-solid", "hdr", "jpeg-format", "jpg-format", "media-image", "media-image-folder", "media-image-list", "media-image-plus", "media-image-xmark", "media-video", "media-video-folder", "media-video-list", "media-video-plus", "media-video-xmark", "mpeg-format", "panorama-enlarge", "panorama-reduce", "png-format", "raw-format", "reduce", "rotate-camera-left", "rotate-camera-right", "screenshot", "svg-format", "tif-format", "tiff-format", "video-camera", "video-camera-off", "webp-format"},
+	"Science": {"atom", "brain", "brain-electricity", "brain-research", "brain-warning", "cooling-square", "cooling-square-solid", "depth", "diameter", "diameter-solid", "dna", "droplet-snow-flake-in", "droplet-snow-flake-in-solid", "flask", "flask-solid", "graduation-cap", "graduation-cap-solid", "heating-square", "heating-square-solid", "inclination", "infinite", "magnet", "magnet-energy", "magnet-solid", "microscope", "microscope-solid", "moon-sat", "planet", "planet-alt", "planet-sat", "planet-solid", "radiation", "radiation-solid", "radius", "radius-solid", "rocket", "round-flask", "round-flask-solid", "rubik-cube", "sine-wave", "square-wave", "test-tube", "test-tube-solid", "vials", "vials-solid"},
+	"Security": {"historic-shield", "historic-shield-alt", "ip-address-tag", "key", "key-back", "key-minus", "key-plus", "key-xmark", "lock", "lock-slash", "open-vpn", "password-check", "password-cursor", "password-xmark", "pc-check", "pc-firewall", "pc-no-entry", "pc-warning", "security-pass", "shield", "shield-alert", "shield-alt", "shield-broken", "shield-check", "shield-download", "shield-eye", "shield-loading", "shield-minus", "shield-plus-in", "shield-question", "shield-search", "shield-upload", "shield-xmark", "tower", "tower-check", "tower-no-access", "tower-warning"},
+	"Shapes": {"circle", "flare", "heptagon", "hexagon", "hexagon-plus", "minus-hexagon", "octagon", "pentagon", "rhombus", "square", "triangle"},
+	"Shopping": {"arrows-up-from-line", "barcode", "cart", "cart-alt", "cart-minus", "cart-plus", "clock-rotate-right", "consumable", "glass-fragile", "scan-barcode", "shopping-bag", "shopping-bag-arrow-down", "shopping-bag-arrow-up", "shopping-bag-check", "shopping-bag-minus", "shopping-bag-plus", "shopping-bag-pocket", "shopping-bag-warning", "shopping-code", "shopping-code-check", "shopping-code-xmark", "simple-cart", "user-bag", "user-cart"},
+	"Social": {"app-store", "app-store-solid", "badge-check", "behance", "behance-tag", "discord", "dribbble", "facebook", "facebook-tag", "google", "google-circle", "hashtag", "instagram", "linkedin", "mastodon", "medium", "peerlist", "pinterest", "pocket", "post", "post-solid", "rss-feed", "rss-feed-tag", "snapchat", "stackoverflow", "telegram", "telegram-circle", "threads", "thumbs-down", "thumbs-up", "tiktok", "twitter", "x", "yelp", "youtube"},
+	"System": {"accessibility", "accessibility-sign", "accessibility-tech", "app-window", "apple-mac", "apple-shortcuts", "apple-shortcuts-solid", "battery-25", "battery-50", "battery-75", "battery-charging", "battery-empty", "battery-full", "battery-slash", "battery-warning", "bin", "bin-full", "bin-half", "bin-minus-in", "bin-plus-in", "brightness", "brightness-window", "calculator", "calendar", "calendar-arrow-down", "calendar-arrow-down-solid", "calendar-arrow-up", "calendar-arrow-up-solid", "calendar-check", "calendar-check-solid", "calendar-minus", "calendar-minus-solid", "calendar-plus", "calendar-plus-solid", "calendar-rotate", "calendar-rotate-solid", "calendar-xmark", "calendar-xmark-solid", "control-slider", "cookie", "cpu", "cpu-warning", "cursor-pointer", "dashboard", "dashboard-dots", "dashboard-speed", "download-data-window", "eject", "energy-usage-window", "favourite-window", "finder", "fingerprint-window", "half-cookie", "heart-arrow-down", "input-field", "input-output", "ios-settings", "key-command", "linux", "lock-square", "log-in", "log-no-access", "log-out", "mac-control-key", "mac-dock", "mac-option-key", "mac-os-window", "mouse-button-left", "mouse-button-right", "mouse-scroll-wheel", "multi-mac-os-window", "multi-window", "new-tab", "off-tag", "on-tag", "pause-window", "pc-mouse", "reload-window", "safari", "search-engine", "search-window", "secure-window", "select-window", "settings", "settings-profiles", "square-cursor", "square-cursor-solid", "switch-off", "switch-on", "system-restart", "system-shut", "terminal", "terminal-tag", "type", "upload-data-window", "warning-window", "web-window", "web-window-energy-consumption", "web-window-energy-consumption-solid", "web-window-solid", "web-window-xmark", "web-window-xmark-solid", "window-check", "window-lock", "window-no-access", "window-tabs", "window-tabs-solid", "window-xmark", "windows"},
+	"Tools": {"angle-tool", "hammer", "tools", "wrench"},
+	"Transport": {"airplane", "airplane-helix", "airplane-helix-45deg", "airplane-off", "airplane-rotation", "bicycle", "bus", "bus-green", "bus-stop", "car", "delivery", "delivery-truck", "drone", "drone-charge-full", "drone-charge-half", "drone-charge-low", "drone-check", "drone-landing", "drone-refresh", "drone-take-off", "drone-xmark", "ev-charge", "ev-charge-alt", "ev-plug", "ev-plug-charging", "ev-plug-xmark", "ev-station", "ev-tag", "gas-tank", "gas-tank-droplet", "hand-brake", "hot-air-balloon", "metro", "motorcycle", "package", "package-lock", "packages", "parking", "train", "tram", "truck", "truck-green", "truck-length", "vehicle-green"},
+	"Typography": {"asterisk", "c-square", "f-square", "h-square", "n-square", "o-square", "x-square", "y-square", "z-square"},
+	"Users": {"community", "group", "learning", "people-tag", "profile-circle", "user", "user-badge-check", "user-circle", "user-crown", "user-love", "user-plus", "user-square", "user-star", "user-xmark"},
+	"Weather": {"cloud", "cloud-sunny", "dew-point", "fog", "heavy-rain", "rain", "snow", "snow-flake", "sun-light", "temperature-down", "temperature-high", "temperature-low", "temperature-up", "thunderstorm", "wind"},
+}
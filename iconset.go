@@ -0,0 +1,52 @@
+package templiconoir
+
+import "fmt"
+
+// IconSet is a collection of icons backed by a Source.
+// Icon sets are independent of each other and of the package-level icons.
+type IconSet struct {
+	source Source
+}
+
+// NewIconSet creates an IconSet reading its icons from source.
+func NewIconSet(source Source) *IconSet {
+	return &IconSet{source: source}
+}
+
+// Source returns the Source of the icon set.
+func (s *IconSet) Source() Source {
+	return s.source
+}
+
+// Icon returns the named icon of the set, without checking that it exists.
+// Rendering an icon missing from the set reports the error like any other icon.
+func (s *IconSet) Icon(name string) *Icon {
+	return &Icon{Name: name, Size: "24", source: s.source}
+}
+
+// Lookup returns the named icon or alias of the set, if it exists.
+func (s *IconSet) Lookup(name string) (*Icon, bool) {
+	if _, err := s.source.Icon(name); err != nil {
+		return nil, false
+	}
+	return s.Icon(name), true
+}
+
+// MustLookup is like Lookup but panics if the icon does not exist.
+func (s *IconSet) MustLookup(name string) *Icon {
+	icon, found := s.Lookup(name)
+	if !found {
+		panic(fmt.Sprintf("templiconoir: icon '%s' not found", name))
+	}
+	return icon
+}
+
+// Names returns the sorted names of the icons of the set.
+func (s *IconSet) Names() ([]string, error) {
+	return s.source.Names()
+}
+
+// Info returns the metadata of the icon set.
+func (s *IconSet) Info() (DatasetInfo, error) {
+	return s.source.Info()
+}
@@ -0,0 +1,92 @@
+package templiconoir
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestIconSet_Lookup(t *testing.T) {
+	set := NewIconSet(NewBytesSource([]byte(testIconSetJSON)))
+
+	tests := []struct {
+		name   string
+		lookup string
+		found  bool
+	}{
+		{name: "Icon name", lookup: "logo", found: true},
+		{name: "Alias", lookup: "brand", found: true},
+		{name: "Icon of the default set", lookup: "check-circle", found: false},
+		{name: "Unknown name", lookup: "non-existing-icon", found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			icon, found := set.Lookup(tt.lookup)
+			if found != tt.found {
+				t.Fatalf("Lookup(%q) found = %v, want %v", tt.lookup, found, tt.found)
+			}
+			if found && icon.Name != tt.lookup {
+				t.Errorf("Lookup(%q).Name = %q, want %q", tt.lookup, icon.Name, tt.lookup)
+			}
+		})
+	}
+}
+
+func TestIconSet_Icon(t *testing.T) {
+	set := NewIconSet(NewBytesSource([]byte(testIconSetJSON)))
+
+	result := makeSVGTag(set.Icon("mark"))
+	expected := `<svg xmlns="http://www.w3.org/2000/svg" width="28.8" height="24" viewBox="-2 0 24 20" fill="none" stroke-width="1.5" aria-hidden="true"><path d='M2 2'/></svg>`
+	if result != expected {
+		t.Errorf("makeSVGTag() = %q, want %q", result, expected)
+	}
+
+	// Customizing an icon of the set keeps its source
+	result = makeSVGTag(ConfigureIcon(set.Icon("logo")).SetSize(32).GetIcon())
+	if !strings.Contains(result, `width="32"`) || !strings.Contains(result, "<path d='M1 1'/>") {
+		t.Errorf("makeSVGTag() = %q, want the resized brand logo", result)
+	}
+
+	// Icons missing from the set are reported on render
+	result = makeSVGTag(set.Icon("check-circle"))
+	if result != `<!-- Error: icon not found: 'check-circle' -->` {
+		t.Errorf("makeSVGTag() = %q, want an error comment", result)
+	}
+}
+
+func TestIconSet_MustLookup(t *testing.T) {
+	set := NewIconSet(NewBytesSource([]byte(testIconSetJSON)))
+
+	if icon := set.MustLookup("logo"); icon.Name != "logo" {
+		t.Errorf("MustLookup(\"logo\").Name = %q, want \"logo\"", icon.Name)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected MustLookup to panic for an unknown icon")
+		}
+	}()
+	set.MustLookup("non-existing-icon")
+}
+
+func TestIconSet_Handler(t *testing.T) {
+	handler := NewHandler()
+	handler.Source = NewBytesSource([]byte(testIconSetJSON))
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/logo.svg", nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", recorder.Code, http.StatusOK)
+	}
+	if !strings.Contains(recorder.Body.String(), "<path d='M1 1'/>") {
+		t.Errorf("body = %q, want the brand logo", recorder.Body.String())
+	}
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/check-circle.svg", nil))
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", recorder.Code, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,62 @@
+package templiconoir
+
+import (
+	"time"
+
+	"github.com/tidwall/gjson"
+)
+
+// DatasetInfo holds the metadata of the icons dataset.
+type DatasetInfo struct {
+	Prefix       string    // Iconify prefix of the icon set (e.g., "iconoir")
+	Name         string    // Name of the icon set
+	Version      string    // Version of the icon set
+	Total        int       // Number of icons, as reported by the dataset
+	Author       Author    // Author of the icon set
+	License      License   // License of the icon set
+	LastModified time.Time // Last modification time of the dataset
+}
+
+// Author describes the author of an icon set.
+type Author struct {
+	Name string
+	URL  string
+}
+
+// License describes the license of an icon set.
+type License struct {
+	Title string
+	SPDX  string
+	URL   string
+}
+
+// Info returns the metadata of the embedded icons dataset.
+func Info() (DatasetInfo, error) {
+	return defaultSource.Info()
+}
+
+// parseDatasetInfo reads the `prefix`, `info` and `lastModified` properties of an Iconify dataset,
+// which must have been validated.
+func parseDatasetInfo(data []byte) DatasetInfo {
+	info := gjson.GetBytes(data, "info")
+	datasetInfo := DatasetInfo{
+		Prefix:  gjson.GetBytes(data, "prefix").String(),
+		Name:    info.Get("name").String(),
+		Version: info.Get("version").String(),
+		Total:   int(info.Get("total").Int()),
+		Author: Author{
+			Name: info.Get("author.name").String(),
+			URL:  info.Get("author.url").String(),
+		},
+		License: License{
+			Title: info.Get("license.title").String(),
+			SPDX:  info.Get("license.spdx").String(),
+			URL:   info.Get("license.url").String(),
+		},
+	}
+	if lastModified := gjson.GetBytes(data, "lastModified"); lastModified.Exists() {
+		datasetInfo.LastModified = time.Unix(lastModified.Int(), 0).UTC()
+	}
+
+	return datasetInfo
+}
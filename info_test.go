@@ -0,0 +1,41 @@
+package templiconoir
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestInfo_EmbeddedDataset(t *testing.T) {
+	info, err := Info()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := DatasetInfo{
+		Prefix:       "iconoir",
+		Name:         DatasetName,
+		Version:      DatasetVersion,
+		Total:        DatasetTotal,
+		Author:       Author{Name: DatasetAuthor, URL: DatasetAuthorURL},
+		License:      License{Title: "MIT", SPDX: DatasetLicense, URL: DatasetLicenseURL},
+		LastModified: time.Unix(DatasetLastModified, 0).UTC(),
+	}
+	if info != expected {
+		t.Errorf("Info() = %+v, want %+v", info, expected)
+	}
+}
+
+func TestInfo_parseDatasetInfo(t *testing.T) {
+	t.Run("Invalid JSON", func(t *testing.T) {
+		if _, err := NewBytesSource([]byte(`{"info": `)).Info(); !errors.Is(err, ErrDatasetInvalid) {
+			t.Errorf("Info() error = %v, want %v", err, ErrDatasetInvalid)
+		}
+	})
+
+	t.Run("Missing metadata", func(t *testing.T) {
+		if info := parseDatasetInfo([]byte(`{"icons": {}}`)); info != (DatasetInfo{}) {
+			t.Errorf("parseDatasetInfo() = %+v, want the zero value", info)
+		}
+	})
+}
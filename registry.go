@@ -0,0 +1,23 @@
+package templiconoir
+
+import "fmt"
+
+// Lookup returns the icon registered under name, which can be any Iconify icon name or alias
+// of the dataset (e.g., "check-circle", "1st-medal"). The returned icon is a copy, so it can be
+// configured without affecting the package-level icons.
+func Lookup(name string) (*Icon, bool) {
+	icon, found := iconRegistry[name]
+	if !found {
+		return nil, false
+	}
+	return icon.clone(), true
+}
+
+// MustLookup is like Lookup but panics if no icon is registered under name.
+func MustLookup(name string) *Icon {
+	icon, found := Lookup(name)
+	if !found {
+		panic(fmt.Sprintf("templiconoir: icon '%s' not found", name))
+	}
+	return icon
+}
@@ -0,0 +1,78 @@
+package templiconoir
+
+import (
+	"testing"
+
+	"github.com/tidwall/gjson"
+)
+
+func TestRegistry_Lookup(t *testing.T) {
+	tests := []struct {
+		name         string
+		lookup       string
+		expectedName string
+		found        bool
+	}{
+		{name: "Icon name", lookup: "check-circle", expectedName: "check-circle", found: true},
+		{name: "Solid icon name", lookup: "check-circle-solid", expectedName: "check-circle-solid", found: true},
+		{name: "Alias", lookup: "1st-medal", expectedName: "medal-1st", found: true},
+		{name: "Unknown name", lookup: "non-existing-icon", found: false},
+		{name: "Empty name", lookup: "", found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			icon, found := Lookup(tt.lookup)
+			if found != tt.found {
+				t.Fatalf("Lookup(%q) found = %v, want %v", tt.lookup, found, tt.found)
+			}
+			if found && icon.Name != tt.expectedName {
+				t.Errorf("Lookup(%q).Name = %q, want %q", tt.lookup, icon.Name, tt.expectedName)
+			}
+		})
+	}
+}
+
+func TestRegistry_LookupReturnsClones(t *testing.T) {
+	icon, _ := Lookup("check-circle")
+	icon.Size = "48"
+	icon.Color = "#FF0000"
+
+	if CheckCircle.Size != "24" || CheckCircle.Color != "" {
+		t.Errorf("package-level icon modified through Lookup: %+v", CheckCircle)
+	}
+
+	other, _ := Lookup("check-circle")
+	if other == icon {
+		t.Errorf("expected a fresh clone on every Lookup")
+	}
+}
+
+func TestRegistry_MustLookup(t *testing.T) {
+	if icon := MustLookup("xmark"); icon.Name != "xmark" {
+		t.Errorf("MustLookup(\"xmark\").Name = %q, want \"xmark\"", icon.Name)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected MustLookup to panic for an unknown icon")
+		}
+	}()
+	MustLookup("non-existing-icon")
+}
+
+func TestRegistry_CoversDataset(t *testing.T) {
+	data, err := defaultSource.bytes()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, path := range []string{"icons", "aliases"} {
+		gjson.GetBytes(data, path).ForEach(func(key, _ gjson.Result) bool {
+			if _, found := Lookup(key.String()); !found {
+				t.Errorf("%s entry %q is not registered", path, key.String())
+			}
+			return true
+		})
+	}
+}
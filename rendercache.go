@@ -0,0 +1,123 @@
+package templiconoir
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+	"slices"
+	"strings"
+)
+
+// renderCache holds the inline SVG of rendered icons, disabled unless set with SetRenderCachePolicy.
+var renderCache = lruCache[renderKey, []byte]{policy: CacheNone}
+
+// renderSeed seeds the configuration hashes of the render cache.
+var renderSeed = maphash.MakeSeed()
+
+// renderKey identifies the inline SVG of an icon in the render cache.
+type renderKey struct {
+	source *JSONSource
+	name   string
+	config uint64 // Hash of the configuration of the icon
+}
+
+// SetRenderCachePolicy enables the cache of the inline SVG of rendered icons, so that rendering
+// an icon again with the same configuration writes the cached markup at once. It is disabled
+// (CacheNone) by default. Changing the policy discards the cached markup.
+//
+// Icons generating their element IDs on each render (labelled icons or bodies with IDs, without
+// an IDPrefix) and icons read from a custom Source implementation are never cached.
+func SetRenderCachePolicy(policy CachePolicy, maxEntries int) {
+	renderCache.setPolicy(policy, maxEntries)
+}
+
+// GetRenderCacheStats returns the statistics of the render cache.
+func GetRenderCacheStats() CacheStats {
+	return renderCache.stats()
+}
+
+// ResetRenderCache empties the render cache and zeroes its statistics.
+func ResetRenderCache() {
+	renderCache.reset()
+}
+
+// cachedSVG returns the inline SVG of the icon from the render cache.
+func (i *Icon) cachedSVG() ([]byte, bool) {
+	if renderCache.disabled() {
+		return nil, false
+	}
+	key, ok := i.renderKey()
+	if !ok {
+		return nil, false
+	}
+	return renderCache.get(key)
+}
+
+// cacheSVG stores svg, the inline SVG of the icon drawn from data, in the render cache.
+func (i *Icon) cacheSVG(data IconData, svg []byte) {
+	if renderCache.disabled() {
+		return
+	}
+	// Generated IDs must be unique to each render
+	if i.IDPrefix == "" && (i.isLabelled() || strings.Contains(data.Body, ` id="`)) {
+		return
+	}
+	if key, ok := i.renderKey(); ok {
+		renderCache.add(key, bytes.Clone(svg))
+	}
+}
+
+// renderKey returns the key of the icon in the render cache, and whether it can be cached:
+// icons with a preset body or from a custom Source are not.
+func (i *Icon) renderKey() (renderKey, bool) {
+	if i.body != "" {
+		return renderKey{}, false
+	}
+	source, ok := i.getSource().(*JSONSource)
+	if !ok {
+		return renderKey{}, false
+	}
+	return renderKey{source: source, name: i.Name, config: i.configHash()}, true
+}
+
+// configHash returns a hash of the configuration of the icon affecting its inline SVG.
+// Attributes are hashed in the sorted order they are rendered in.
+func (i *Icon) configHash() uint64 {
+	var h maphash.Hash
+	h.SetSeed(renderSeed)
+
+	for _, s := range [...]string{string(i.Size), i.StrokeWidth, i.Color, i.Fill, i.Stroke, i.IDPrefix, i.Label, i.Description} {
+		writeHashString(&h, s)
+	}
+	var dimensions [32]byte
+	for n, v := range [...]float64{i.Left, i.Top, i.Width, i.Height} {
+		binary.LittleEndian.PutUint64(dimensions[n*8:], math.Float64bits(v))
+	}
+	_, _ = h.Write(dimensions[:])
+
+	var stack [16]string
+	keys := stack[:0]
+	for key := range i.Attrs {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		// Only string values are rendered
+		if value, ok := i.Attrs[key].(string); ok {
+			writeHashString(&h, key)
+			writeHashString(&h, value)
+		}
+	}
+
+	return h.Sum64()
+}
+
+// writeHashString writes s to h, prefixed with its length so that consecutive strings
+// cannot be confused.
+func writeHashString(h *maphash.Hash, s string) {
+	var length [8]byte
+	binary.LittleEndian.PutUint64(length[:], uint64(len(s)))
+	_, _ = h.Write(length[:])
+	_, _ = h.WriteString(s)
+}
@@ -0,0 +1,162 @@
+package templiconoir
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+// enableRenderCache enables the render cache for the duration of the test.
+func enableRenderCache(t *testing.T, policy CachePolicy, maxEntries int) {
+	t.Helper()
+	SetRenderCachePolicy(policy, maxEntries)
+	ResetRenderCache()
+	t.Cleanup(func() {
+		SetRenderCachePolicy(CacheNone, 0)
+		ResetRenderCache()
+	})
+}
+
+func renderString(t *testing.T, component templ.Component) string {
+	t.Helper()
+	var sb strings.Builder
+	if err := component.Render(context.Background(), &sb); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return sb.String()
+}
+
+func TestRenderCache_Disabled(t *testing.T) {
+	renderString(t, CheckCircle)
+	renderString(t, CheckCircle)
+	if stats := GetRenderCacheStats(); stats != (CacheStats{}) {
+		t.Errorf("GetRenderCacheStats() = %+v, want zero when disabled", stats)
+	}
+}
+
+func TestRenderCache_Renders(t *testing.T) {
+	tests := []struct {
+		name          string
+		first         templ.Component
+		second        templ.Component
+		expectedStats CacheStats
+	}{
+		{
+			name:          "Same icon",
+			first:         CheckCircle,
+			second:        CheckCircle,
+			expectedStats: CacheStats{Hits: 1, Misses: 1, Entries: 1},
+		},
+		{
+			name:          "Same configuration of different builders",
+			first:         CheckCircle.Config().SetSize(32).SetAttrs(templ.Attributes{"class": "icon", "data-state": "done"}),
+			second:        CheckCircle.Config().SetSize(32).SetAttrs(templ.Attributes{"data-state": "done", "class": "icon"}),
+			expectedStats: CacheStats{Hits: 1, Misses: 1, Entries: 1},
+		},
+		{
+			name:          "Different configurations",
+			first:         CheckCircle.Config().SetColor("red"),
+			second:        CheckCircle.Config().SetColor("blue"),
+			expectedStats: CacheStats{Misses: 2, Entries: 2},
+		},
+		{
+			name:          "Different attributes",
+			first:         CheckCircle.Config().SetAttrs(templ.Attributes{"class": "a"}),
+			second:        CheckCircle.Config().SetAttrs(templ.Attributes{"class": "b"}),
+			expectedStats: CacheStats{Misses: 2, Entries: 2},
+		},
+		{
+			name:          "Labelled icons generate their IDs",
+			first:         CheckCircle.Config().SetLabel("Done"),
+			second:        CheckCircle.Config().SetLabel("Done"),
+			expectedStats: CacheStats{Misses: 2},
+		},
+		{
+			name:          "Labelled icons with an ID prefix",
+			first:         CheckCircle.Config().SetLabel("Done").SetIDPrefix("done"),
+			second:        CheckCircle.Config().SetLabel("Done").SetIDPrefix("done"),
+			expectedStats: CacheStats{Hits: 1, Misses: 1, Entries: 1},
+		},
+		{
+			name:          "Bodies with element IDs",
+			first:         Podcast,
+			second:        Podcast,
+			expectedStats: CacheStats{Misses: 2},
+		},
+		{
+			name:          "Custom sources",
+			first:         &Icon{Name: "existing-icon", Size: "24", source: mockSource{"existing-icon": `<path d="M0 0"/>`}},
+			second:        &Icon{Name: "existing-icon", Size: "24", source: mockSource{"existing-icon": `<path d="M0 0"/>`}},
+			expectedStats: CacheStats{},
+		},
+		{
+			name:          "Sprite references",
+			first:         CheckCircle.Config().SetRenderMode(RenderSprite),
+			second:        CheckCircle.Config().SetRenderMode(RenderSprite),
+			expectedStats: CacheStats{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enableRenderCache(t, CacheUnbounded, 0)
+
+			first := renderString(t, tt.first)
+			second := renderString(t, tt.second)
+			if tt.expectedStats.Hits > 0 && first != second {
+				t.Errorf("cached render = %q, want %q", second, first)
+			}
+			if stats := GetRenderCacheStats(); stats != tt.expectedStats {
+				t.Errorf("GetRenderCacheStats() = %+v, want %+v", stats, tt.expectedStats)
+			}
+		})
+	}
+}
+
+func TestRenderCache_SVG(t *testing.T) {
+	enableRenderCache(t, CacheLRU, 1)
+
+	expected := renderString(t, CheckCircle)
+	for _, icon := range []*Icon{CheckCircle, Xmark, CheckCircle} {
+		svg, err := icon.SVG()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var sb strings.Builder
+		if err := icon.WriteSVG(&sb); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if sb.String() != svg {
+			t.Errorf("WriteSVG() wrote %q, want %q", sb.String(), svg)
+		}
+		if icon == CheckCircle && svg != expected {
+			t.Errorf("SVG() = %q, want %q", svg, expected)
+		}
+	}
+
+	expectedStats := CacheStats{Hits: 4, Misses: 3, Evictions: 2, Entries: 1}
+	if stats := GetRenderCacheStats(); stats != expectedStats {
+		t.Errorf("GetRenderCacheStats() = %+v, want %+v", stats, expectedStats)
+	}
+}
+
+func TestRenderCache_Allocations(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping allocation test in short mode")
+	}
+	enableRenderCache(t, CacheLRU, 10)
+
+	component := CheckCircle.Config().SetSize(32).SetAttrs(templ.Attributes{"class": "icon"})
+	ctx := context.Background()
+	allocs := testing.AllocsPerRun(100, func() {
+		if err := component.Render(ctx, io.Discard); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Render() allocated %v times per run, want 0", allocs)
+	}
+}
@@ -0,0 +1,227 @@
+package templiconoir
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/tidwall/gjson"
+)
+
+// SearchResult is an icon matching a search query.
+type SearchResult struct {
+	Name  string  // Name of the matching icon
+	Score float64 // Relevance of the match, higher is better
+}
+
+// searchEntry is a searchable name (icon or alias) of the dataset.
+type searchEntry struct {
+	name       string   // Icon the entry resolves to
+	tokens     []string // Tokens of the icon or alias name
+	categories []string // Lowercased tokens of the icon categories
+	alias      bool     // Whether the entry is an alias
+}
+
+// searchSynonyms groups words that are used interchangeably when looking for an icon.
+var searchSynonyms = [][]string{
+	{"trash", "bin", "delete", "garbage"},
+	{"add", "plus", "new"},
+	{"remove", "minus"},
+	{"close", "cancel", "xmark"},
+	{"settings", "gear", "cog", "preferences"},
+	{"search", "magnifier", "find"},
+	{"mail", "email", "envelope"},
+	{"user", "person", "profile", "account"},
+	{"home", "house"},
+	{"edit", "pencil"},
+}
+
+var (
+	searchIndex     []searchEntry
+	searchIndexErr  error
+	searchIndexOnce sync.Once
+)
+
+// SearchIcons returns the icons matching query, ranked by relevance. Icon names, aliases and
+// category names are matched by token, prefix, synonym and edit distance, so that
+// "trash" finds `trash`, `bin` and `bin-minus-in`. At most limit results are returned,
+// or every match if limit is not positive.
+func SearchIcons(query string, limit int) []SearchResult {
+	queryTokens := tokenize(query)
+	if len(queryTokens) == 0 {
+		return nil
+	}
+
+	searchIndexOnce.Do(func() {
+		searchIndex, searchIndexErr = buildSearchIndex()
+	})
+	if searchIndexErr != nil {
+		return nil
+	}
+
+	// Keep the best score of every icon, as aliases resolve to the same icon
+	scores := map[string]float64{}
+	for _, entry := range searchIndex {
+		score := scoreEntry(entry, queryTokens)
+		if score > scores[entry.name] {
+			scores[entry.name] = score
+		}
+	}
+
+	results := make([]SearchResult, 0, len(scores))
+	for name, score := range scores {
+		results = append(results, SearchResult{Name: name, Score: score})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Name < results[j].Name
+	})
+
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// buildSearchIndex reads the icons, aliases and categories of the dataset.
+func buildSearchIndex() ([]searchEntry, error) {
+	data, err := defaultSource.bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	categories := map[string][]string{}
+	gjson.GetBytes(data, "categories").ForEach(func(category, names gjson.Result) bool {
+		for _, name := range names.Array() {
+			categories[name.String()] = append(categories[name.String()], tokenize(category.String())...)
+		}
+		return true
+	})
+
+	var index []searchEntry
+	gjson.GetBytes(data, "icons").ForEach(func(key, _ gjson.Result) bool {
+		name := key.String()
+		index = append(index, searchEntry{name: name, tokens: tokenize(name), categories: categories[name]})
+		return true
+	})
+
+	aliases := map[string]gjson.Result{}
+	gjson.GetBytes(data, "aliases").ForEach(func(key, value gjson.Result) bool {
+		aliases[key.String()] = value
+		return true
+	})
+	for alias := range aliases {
+		parent, _, ok := resolveAlias(alias, aliases)
+		if !ok {
+			continue
+		}
+		index = append(index, searchEntry{name: parent, tokens: tokenize(alias), categories: categories[parent], alias: true})
+	}
+
+	return index, nil
+}
+
+// scoreEntry returns how well the entry matches the query tokens, or 0 if a token doesn't match.
+func scoreEntry(entry searchEntry, queryTokens []string) float64 {
+	var score float64
+	for _, queryToken := range queryTokens {
+		best := 0.0
+		for _, token := range entry.tokens {
+			best = max(best, scoreToken(queryToken, token))
+		}
+		for _, category := range entry.categories {
+			// Category matches only count as weak hints
+			best = max(best, scoreToken(queryToken, category)*0.3)
+		}
+		if best == 0 {
+			return 0
+		}
+		score += best
+	}
+
+	// Prefer exact names and names starting with the query
+	joined := strings.Join(queryTokens, "-")
+	name := strings.Join(entry.tokens, "-")
+	switch {
+	case name == joined:
+		score += 2
+	case strings.HasPrefix(name, joined):
+		score += 0.5
+	}
+
+	// Prefer names and shorter names over aliases and longer names
+	if entry.alias {
+		score *= 0.9
+	}
+	return score - 0.01*float64(len(entry.tokens))
+}
+
+// scoreToken returns how well a query token matches a name token.
+func scoreToken(queryToken, token string) float64 {
+	switch {
+	case queryToken == token:
+		return 1
+	case strings.HasPrefix(token, queryToken):
+		return 0.8
+	case areSynonyms(queryToken, token):
+		return 0.7
+	case len(queryToken) >= 4 && strings.Contains(token, queryToken):
+		return 0.5
+	}
+
+	// Tolerate typos in longer words
+	distance := levenshtein(queryToken, token)
+	switch {
+	case distance == 1 && len(queryToken) >= 4:
+		return 0.5
+	case distance == 2 && len(queryToken) >= 6:
+		return 0.3
+	}
+	return 0
+}
+
+func areSynonyms(a, b string) bool {
+	for _, group := range searchSynonyms {
+		var hasA, hasB bool
+		for _, word := range group {
+			hasA = hasA || word == a
+			hasB = hasB || word == b
+		}
+		if hasA && hasB {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenize splits value into lowercase alphanumeric tokens.
+func tokenize(value string) []string {
+	return strings.FieldsFunc(strings.ToLower(value), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
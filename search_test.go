@@ -0,0 +1,103 @@
+package templiconoir
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSearch_SearchIcons(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		limit         int
+		expectedFirst string
+		expected      []string
+	}{
+		{
+			name:          "Exact name ranks first, synonyms follow",
+			query:         "trash",
+			limit:         10,
+			expectedFirst: "trash",
+			expected:      []string{"trash", "bin", "bin-minus-in"},
+		},
+		{
+			name:          "Multiple tokens",
+			query:         "Check Circle",
+			expectedFirst: "check-circle",
+			expected:      []string{"check-circle", "check-circle-solid"},
+		},
+		{
+			name:     "Typos are tolerated",
+			query:    "arow",
+			expected: []string{"arrow-up", "arrow-down"},
+		},
+		{
+			name:          "Aliases resolve to their icon",
+			query:         "1st medal",
+			expectedFirst: "medal-1st",
+		},
+		{
+			name:     "Category names",
+			query:    "weather",
+			expected: []string{"cloud", "snow-flake"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := SearchIcons(tt.query, tt.limit)
+			if len(results) == 0 {
+				t.Fatalf("SearchIcons(%q) returned no results", tt.query)
+			}
+
+			names := make([]string, len(results))
+			for i, result := range results {
+				names[i] = result.Name
+			}
+			if tt.expectedFirst != "" && names[0] != tt.expectedFirst {
+				t.Errorf("SearchIcons(%q)[0] = %q, want %q", tt.query, names[0], tt.expectedFirst)
+			}
+			for _, expected := range tt.expected {
+				if !slices.Contains(names, expected) {
+					t.Errorf("SearchIcons(%q) = %v, expected it to contain %q", tt.query, names, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestSearch_Limit(t *testing.T) {
+	if results := SearchIcons("arrow", 5); len(results) != 5 {
+		t.Errorf("expected 5 results, got %d", len(results))
+	}
+	if results := SearchIcons("arrow", 0); len(results) <= 5 {
+		t.Errorf("expected every match without a limit, got %d", len(results))
+	}
+}
+
+func TestSearch_NoResults(t *testing.T) {
+	for _, query := range []string{"", "  -- ", "zzzzzzzzzz"} {
+		if results := SearchIcons(query, 10); len(results) != 0 {
+			t.Errorf("SearchIcons(%q) = %v, want no results", query, results)
+		}
+	}
+}
+
+func TestSearch_levenshtein(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"arrow", "arrow", 0},
+		{"arow", "arrow", 1},
+		{"kitten", "sitting", 3},
+		{"", "bin", 3},
+	}
+
+	for _, tt := range tests {
+		if result := levenshtein(tt.a, tt.b); result != tt.expected {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, result, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package templiconoir
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/tidwall/gjson"
+)
+
+// Source provides the icons of an icon set.
+// Implementations must be safe for concurrent use.
+type Source interface {
+	// Icon returns the body and viewBox of the named icon or alias.
+	Icon(name string) (IconData, error)
+	// Names returns the sorted names of the icons, aliases excluded.
+	Names() ([]string, error)
+	// Info returns the metadata of the icon set.
+	Info() (DatasetInfo, error)
+}
+
+// Default dimensions of Iconify icons, used when neither the icon nor the set defines them.
+const defaultIconifySize = 16
+
+// gzipMagic starts every gzip stream, and no JSON document.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// IconData is the body of an icon with the viewBox it is drawn in.
+type IconData struct {
+	Body   string
+	Left   float64
+	Top    float64
+	Width  float64
+	Height float64
+}
+
+// viewBox returns the value of the viewBox attribute of the icon.
+func (d IconData) viewBox() string {
+	return string(d.appendViewBox(nil))
+}
+
+// appendViewBox appends the value of the viewBox attribute of the icon.
+func (d IconData) appendViewBox(dst []byte) []byte {
+	dst = appendNumber(dst, d.Left)
+	dst = append(dst, ' ')
+	dst = appendNumber(dst, d.Top)
+	dst = append(dst, ' ')
+	dst = appendNumber(dst, d.Width)
+	dst = append(dst, ' ')
+	return appendNumber(dst, d.Height)
+}
+
+// JSONSource is a Source reading an Iconify JSON icon set, such as Iconoir, Lucide or Tabler.
+// The dataset is read and indexed on first use, and the icons are extracted from it on demand.
+// Gzipped datasets, as emitted by the generator, are decompressed transparently.
+type JSONSource struct {
+	read func() ([]byte, error)
+
+	loadOnce sync.Once
+	data     []byte
+	index    iconIndex
+	info     DatasetInfo
+	err      error
+
+	cache lruCache[string, IconData] // Icons extracted so far
+}
+
+// iconIndex locates the icons and aliases within a dataset, without extracting their bodies.
+type iconIndex struct {
+	defaults IconData                // Dimensions of the set
+	icons    map[string]iconSpan     // Position of the JSON object of each icon
+	aliases  map[string]gjson.Result // Aliases, few enough to be kept parsed
+}
+
+// iconSpan is the position of a JSON value within a dataset.
+type iconSpan struct {
+	offset int
+	length int
+}
+
+// NewFSSource creates a JSONSource reading the named Iconify JSON file from fsys.
+func NewFSSource(fsys fs.FS, name string) *JSONSource {
+	return &JSONSource{read: func() ([]byte, error) {
+		return fs.ReadFile(fsys, name)
+	}}
+}
+
+// NewFileSource creates a JSONSource reading the Iconify JSON file at path.
+func NewFileSource(path string) *JSONSource {
+	return &JSONSource{read: func() ([]byte, error) {
+		return os.ReadFile(path)
+	}}
+}
+
+// NewBytesSource creates a JSONSource from the content of an Iconify JSON file.
+func NewBytesSource(data []byte) *JSONSource {
+	return &JSONSource{read: func() ([]byte, error) {
+		return data, nil
+	}}
+}
+
+// Icon returns the body and viewBox of the named icon or alias.
+// Icons are extracted from the dataset on their first lookup, and then cached
+// according to the cache policy of the source.
+func (s *JSONSource) Icon(name string) (IconData, error) {
+	if err := s.load(); err != nil {
+		return IconData{}, err
+	}
+
+	if icon, cached := s.cache.get(name); cached {
+		return icon, nil
+	}
+
+	icon, exists := s.index.extract(s.data, name)
+	if !exists {
+		return IconData{}, fmt.Errorf("%w: '%s'", ErrIconNotFound, name)
+	}
+	s.cache.add(name, icon)
+
+	return icon, nil
+}
+
+// SetCachePolicy sets how the extracted icons are kept, discarding the cached ones.
+// The maximum number of entries applies to CacheLRU only, which caches nothing when it is
+// lower than 1. The default policy is CacheUnbounded.
+func (s *JSONSource) SetCachePolicy(policy CachePolicy, maxEntries int) {
+	s.cache.setPolicy(policy, maxEntries)
+}
+
+// CacheStats returns the lookup counters and the number of cached icons of the source.
+func (s *JSONSource) CacheStats() CacheStats {
+	return s.cache.stats()
+}
+
+// Preload extracts the named icons or aliases into the cache, or every icon when no name is
+// given, so that their first render doesn't pay for it. Preloading doesn't count as lookups.
+// Names missing from the dataset are reported together, after the other icons are loaded.
+func (s *JSONSource) Preload(names ...string) error {
+	if err := s.load(); err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		names, _ = s.Names()
+	}
+
+	var errs []error
+	for _, name := range names {
+		icon, exists := s.index.extract(s.data, name)
+		if !exists {
+			errs = append(errs, fmt.Errorf("%w: '%s'", ErrIconNotFound, name))
+			continue
+		}
+		s.cache.add(name, icon)
+	}
+	return errors.Join(errs...)
+}
+
+// Reset empties the cache of the source and zeroes its statistics.
+// The dataset itself stays loaded.
+func (s *JSONSource) Reset() {
+	s.cache.reset()
+}
+
+// Body returns the SVG body of the named icon or alias.
+func (s *JSONSource) Body(name string) (string, error) {
+	icon, err := s.Icon(name)
+	return icon.Body, err
+}
+
+// Names returns the sorted names of the icons, aliases excluded.
+func (s *JSONSource) Names() ([]string, error) {
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(s.index.icons))
+	for name := range s.index.icons {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// Info returns the metadata of the icon set.
+func (s *JSONSource) Info() (DatasetInfo, error) {
+	if err := s.load(); err != nil {
+		return DatasetInfo{}, err
+	}
+	return s.info, nil
+}
+
+// bytes returns the raw dataset.
+func (s *JSONSource) bytes() ([]byte, error) {
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	return s.data, nil
+}
+
+// load reads and indexes the dataset once.
+func (s *JSONSource) load() error {
+	s.loadOnce.Do(func() {
+		data, err := s.read()
+		if err != nil {
+			s.err = fmt.Errorf("%w: %w", ErrDatasetUnavailable, err)
+			return
+		}
+		if data, err = decompress(data); err != nil {
+			s.err = fmt.Errorf("%w: %w", ErrDatasetInvalid, err)
+			return
+		}
+
+		// Check for valid JSON (parsing)
+		if !gjson.ValidBytes(data) {
+			s.err = fmt.Errorf("%w: malformed JSON", ErrDatasetInvalid)
+			return
+		}
+
+		s.data = data
+		s.index = indexIcons(data)
+		s.info = parseDatasetInfo(data)
+	})
+	return s.err
+}
+
+// decompress returns the content of a gzipped dataset, recognized by its magic number,
+// and any other dataset unchanged.
+func decompress(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, gzipMagic) {
+		return data, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+// indexIcons records the position of every icon of the dataset, and parses its aliases.
+func indexIcons(data []byte) iconIndex {
+	index := iconIndex{
+		defaults: parseDimensions(gjson.ParseBytes(data), IconData{Width: defaultIconifySize, Height: defaultIconifySize}),
+		icons:    map[string]iconSpan{},
+		aliases:  map[string]gjson.Result{},
+	}
+
+	// Names and aliases are cloned, so that they don't retain the parsed copy of the dataset
+	gjson.GetBytes(data, "icons").ForEach(func(key, value gjson.Result) bool {
+		index.icons[strings.Clone(key.String())] = iconSpan{offset: value.Index, length: len(value.Raw)}
+		return true
+	})
+	gjson.GetBytes(data, "aliases").ForEach(func(key, value gjson.Result) bool {
+		index.aliases[strings.Clone(key.String())] = gjson.Parse(strings.Clone(value.Raw))
+		return true
+	})
+
+	return index
+}
+
+// extract returns the body and viewBox of the named icon or alias of data.
+// Icons inherit the dimensions of the set, and aliases those of their parent icon.
+func (x iconIndex) extract(data []byte, name string) (IconData, bool) {
+	if span, found := x.icons[name]; found {
+		icon, transform := x.parseIcon(data, span)
+		return transform.apply(icon), true
+	}
+
+	// Aliases resolve to the body of their parent icon, combining their transformations with its own
+	if _, isAlias := x.aliases[name]; !isAlias {
+		return IconData{}, false
+	}
+	parent, transform, ok := resolveAlias(name, x.aliases)
+	if !ok {
+		return IconData{}, false
+	}
+	span, found := x.icons[parent]
+	if !found {
+		return IconData{}, false
+	}
+	icon, iconTransform := x.parseIcon(data, span)
+
+	// Dimensions set by the aliases closest to the name take precedence
+	var chain []gjson.Result
+	for alias := name; alias != parent; alias = x.aliases[alias].Get("parent").String() {
+		chain = append(chain, x.aliases[alias])
+	}
+	for i := len(chain) - 1; i >= 0; i-- {
+		icon = parseDimensions(chain[i], icon)
+	}
+	return iconTransform.merge(transform).apply(icon), true
+}
+
+// parseIcon returns the body and viewBox of the icon at span in data, and its transformation,
+// which is applied once the dimensions of the aliases are known.
+func (x iconIndex) parseIcon(data []byte, span iconSpan) (IconData, iconTransform) {
+	value := gjson.ParseBytes(data[span.offset : span.offset+span.length])
+	icon := parseDimensions(value, x.defaults)
+	icon.Body = value.Get("body").String()
+	return icon, parseTransform(value)
+}
+
+// parseDimensions returns icon with the `left`, `top`, `width` and `height` properties
+// of an Iconify entry applied, keeping the values the entry does not define.
+func parseDimensions(value gjson.Result, icon IconData) IconData {
+	if left := value.Get("left"); left.Exists() {
+		icon.Left = left.Float()
+	}
+	if top := value.Get("top"); top.Exists() {
+		icon.Top = top.Float()
+	}
+	if width := value.Get("width"); width.Exists() {
+		icon.Width = width.Float()
+	}
+	if height := value.Get("height"); height.Exists() {
+		icon.Height = height.Float()
+	}
+	return icon
+}
@@ -0,0 +1,222 @@
+package templiconoir
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+)
+
+const testIconSetJSON = `{
+	"prefix": "brand",
+	"info": {"name": "Brand Icons", "version": "1.0.0", "total": 2},
+	"width": 20,
+	"height": 20,
+	"icons": {
+		"logo": {"body": "<path d='M1 1'/>"},
+		"mark": {"body": "<path d='M2 2'/>", "left": -2, "width": 24}
+	},
+	"aliases": {
+		"brand": {"parent": "logo"},
+		"brand-wide": {"parent": "brand", "width": 30},
+		"brand-turned": {"parent": "brand-wide", "rotate": 1}
+	}
+}`
+
+func TestSource_NewBytesSource(t *testing.T) {
+	source := NewBytesSource([]byte(testIconSetJSON))
+
+	body, err := source.Body("brand")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body != "<path d='M1 1'/>" {
+		t.Errorf("Body(\"brand\") = %q, want %q", body, "<path d='M1 1'/>")
+	}
+
+	names, err := source.Names()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"logo", "mark"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Names() = %v, want %v", names, want)
+	}
+
+	info, err := source.Info()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.Prefix != "brand" || info.Name != "Brand Icons" || info.Total != 2 {
+		t.Errorf("Info() = %+v, want the brand set metadata", info)
+	}
+}
+
+func TestSource_Dimensions(t *testing.T) {
+	source := NewBytesSource([]byte(testIconSetJSON))
+
+	tests := []struct {
+		name            string
+		iconName        string
+		expectedViewBox string
+	}{
+		{name: "Dimensions of the set", iconName: "logo", expectedViewBox: "0 0 20 20"},
+		{name: "Dimensions of the icon", iconName: "mark", expectedViewBox: "-2 0 24 20"},
+		{name: "Alias inherits its parent", iconName: "brand", expectedViewBox: "0 0 20 20"},
+		{name: "Alias overrides its parent", iconName: "brand-wide", expectedViewBox: "0 0 30 20"},
+		{name: "Rotated alias swaps the dimensions", iconName: "brand-turned", expectedViewBox: "0 0 20 30"},
+		{name: "Iconify defaults", iconName: "default", expectedViewBox: "0 0 16 16"},
+	}
+
+	defaults := NewBytesSource([]byte(`{"icons": {"default": {"body": "<path/>"}}}`))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := source
+			if tt.iconName == "default" {
+				current = defaults
+			}
+			icon, err := current.Icon(tt.iconName)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if icon.viewBox() != tt.expectedViewBox {
+				t.Errorf("Icon(%q).viewBox() = %q, want %q", tt.iconName, icon.viewBox(), tt.expectedViewBox)
+			}
+		})
+	}
+}
+
+func TestSource_NewFileSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "brand.json")
+	if err := os.WriteFile(path, []byte(testIconSetJSON), 0o600); err != nil {
+		t.Fatalf("failed to write icon set: %v", err)
+	}
+
+	body, err := NewFileSource(path).Body("mark")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body != "<path d='M2 2'/>" {
+		t.Errorf("Body(\"mark\") = %q, want %q", body, "<path d='M2 2'/>")
+	}
+}
+
+func TestSource_Errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		source        Source
+		expectedError error
+	}{
+		{
+			name:          "Missing file",
+			source:        NewFileSource(filepath.Join(t.TempDir(), "missing.json")),
+			expectedError: ErrDatasetUnavailable,
+		},
+		{
+			name:          "Missing file in FS",
+			source:        NewFSSource(mockInvalidJSONFS(testIconSetJSON), "missing.json"),
+			expectedError: ErrDatasetUnavailable,
+		},
+		{
+			name:          "Invalid JSON",
+			source:        NewBytesSource([]byte(`{"icons": invalid}`)),
+			expectedError: ErrDatasetInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.source.Icon("logo"); !errors.Is(err, tt.expectedError) {
+				t.Errorf("Icon() error = %v, want %v", err, tt.expectedError)
+			}
+			if _, err := tt.source.Names(); !errors.Is(err, tt.expectedError) {
+				t.Errorf("Names() error = %v, want %v", err, tt.expectedError)
+			}
+			if _, err := tt.source.Info(); !errors.Is(err, tt.expectedError) {
+				t.Errorf("Info() error = %v, want %v", err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestSource_DefaultSource(t *testing.T) {
+	if DefaultSource() != defaultSource {
+		t.Errorf("DefaultSource() does not return the embedded dataset")
+	}
+
+	names, err := DefaultSource().Names()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Contains(names, "check-circle") || slices.Contains(names, "1st-medal") {
+		t.Errorf("Names() should list the icons of the embedded dataset, aliases excluded")
+	}
+}
+
+func TestSource_ExtractsOnDemand(t *testing.T) {
+	source := NewBytesSource([]byte(testIconSetJSON))
+
+	if _, err := source.Icon("brand-turned"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := source.Icon("unknown"); !errors.Is(err, ErrIconNotFound) {
+		t.Fatalf("Icon(\"unknown\") error = %v, want %v", err, ErrIconNotFound)
+	}
+	if len(source.index.icons) != 2 || len(source.index.aliases) != 3 {
+		t.Errorf("index has %d icons and %d aliases, want 2 and 3", len(source.index.icons), len(source.index.aliases))
+	}
+	if stats := source.CacheStats(); stats.Entries != 1 || stats.Misses != 2 {
+		t.Errorf("CacheStats() = %+v, want 1 entry and 2 misses", stats)
+	}
+}
+
+func TestSource_ConcurrentLookups(t *testing.T) {
+	source := NewBytesSource([]byte(testIconSetJSON))
+	names := []string{"logo", "mark", "brand", "brand-wide", "brand-turned"}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, name := range names {
+				if _, err := source.Icon(name); err != nil {
+					t.Errorf("Icon(%q) unexpected error: %v", name, err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if stats := source.CacheStats(); stats.Entries != len(names) {
+		t.Errorf("cached %d icons, want %d", stats.Entries, len(names))
+	}
+}
+
+func TestSource_Gzipped(t *testing.T) {
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	if _, err := zw.Write([]byte(testIconSetJSON)); err != nil {
+		t.Fatalf("failed to compress icon set: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("failed to compress icon set: %v", err)
+	}
+
+	body, err := NewBytesSource(compressed.Bytes()).Body("brand-wide")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body != "<path d='M1 1'/>" {
+		t.Errorf("Body(\"brand-wide\") = %q, want %q", body, "<path d='M1 1'/>")
+	}
+
+	truncated := compressed.Bytes()[:compressed.Len()/2]
+	if _, err := NewBytesSource(truncated).Icon("logo"); !errors.Is(err, ErrDatasetInvalid) {
+		t.Errorf("Icon() on a truncated dataset error = %v, want %v", err, ErrDatasetInvalid)
+	}
+}
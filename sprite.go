@@ -0,0 +1,101 @@
+package templiconoir
+
+import "github.com/a-h/templ"
+
+// defaultSpritePrefix is prepended to icon names to build the IDs of the sprite sheet symbols,
+// when the icon set has no Iconify prefix.
+const defaultSpritePrefix = "iconoir"
+
+// RenderMode represents how an icon is emitted in the page.
+type RenderMode int
+
+const (
+	// RenderAuto renders the full SVG inline, or a sprite reference when a Collector is attached
+	// to the rendering context and the icon has no fill or stroke overrides.
+	RenderAuto RenderMode = iota
+	// RenderInline renders the full SVG markup of the icon.
+	RenderInline
+	// RenderSprite renders a <use> reference to the icon's <symbol> in a sprite sheet (see SpriteSheet).
+	// Fill and stroke overrides are not applied, since the symbol body is shared.
+	RenderSprite
+)
+
+// SpriteSheet returns a hidden <svg> holding one <symbol> per icon, to be referenced by icons
+// rendered with RenderSprite. Duplicated icons are emitted once.
+func SpriteSheet(icons ...*Icon) templ.Component {
+	return templ.Raw(makeSpriteSheet(icons))
+}
+
+// spriteID returns the ID of the sprite sheet symbol for the icon, scoped by the prefix of its icon set.
+func spriteID(icon *Icon) string {
+	return string(appendSpriteID(nil, icon))
+}
+
+// appendSpriteID appends the ID of the sprite sheet symbol for the icon, without allocating.
+func appendSpriteID(dst []byte, icon *Icon) []byte {
+	prefix := defaultSpritePrefix
+	if info, err := icon.getSource().Info(); err == nil && info.Prefix != "" {
+		prefix = info.Prefix
+	}
+	dst = append(dst, prefix...)
+	dst = append(dst, '-')
+	return append(dst, icon.Name...)
+}
+
+func makeSpriteSheet(icons []*Icon) string {
+	dst := []byte(`<svg xmlns="http://www.w3.org/2000/svg" width="0" height="0" style="position:absolute" aria-hidden="true">`)
+
+	seen := make(map[string]struct{}, len(icons))
+	for _, icon := range icons {
+		id := spriteID(icon)
+		if _, done := seen[id]; done {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		dst = appendSymbol(dst, icon)
+	}
+
+	dst = append(dst, `</svg>`...)
+	return string(dst)
+}
+
+// appendSymbol appends the <symbol> definition of the icon.
+// Inner stroke widths of the default value are stripped so that the width set on the referencing
+// <svg> applies, while the deliberately different ones are kept as in inline rendering.
+func appendSymbol(dst []byte, icon *Icon) []byte {
+	data, err := icon.data()
+	if err != nil {
+		return append(dst, errorSVGComment(err)...)
+	}
+
+	id := spriteID(icon)
+	dst = append(dst, `<symbol id="`...)
+	dst = append(dst, id...)
+	dst = append(dst, `" viewBox="`...)
+	dst = data.appendViewBox(dst)
+	dst = append(dst, `">`...)
+	dst = appendBody(dst, data.Body, bodyRewrite{prefix: idPrefix{custom: id + "-"}, removeStrokeWidth: defaultStrokeWidth})
+	return append(dst, `</symbol>`...)
+}
+
+// rendersAsSprite reports whether the icon is rendered as a sprite reference.
+func (i *Icon) rendersAsSprite(collecting bool) bool {
+	switch i.Mode {
+	case RenderSprite:
+		return true
+	case RenderAuto:
+		return collecting && i.Fill == "" && i.Stroke == ""
+	default:
+		return false
+	}
+}
+
+// appendSpriteUse appends an <svg> referencing the <symbol> of the icon. Its data must have been
+// looked up, so that a typo doesn't silently point to a missing symbol.
+func appendSpriteUse(dst []byte, icon *Icon, data IconData) []byte {
+	dst = appendSVGOpenTag(dst, icon, data, icon.idPrefix(icon.isLabelled()))
+	dst = append(dst, `<use href="#`...)
+	dst = appendSpriteID(dst, icon)
+	return append(dst, `"/></svg>`...)
+}
@@ -0,0 +1,87 @@
+package templiconoir
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func TestSprite_SpriteSheet(t *testing.T) {
+	outline := &Icon{Name: "outline-icon", Size: "24", Type: "Outline", body: `<g fill="none" stroke="currentColor" stroke-width="1.5"><path d="M3 3h18"/></g>`}
+	masked := &Icon{Name: "masked-icon", Size: "24", Type: "Outline", body: `<defs><path id="p0" d="M0 0"/></defs><use href="#p0"/>`}
+
+	var sb strings.Builder
+	if err := SpriteSheet(outline, masked, outline).Render(context.Background(), &sb); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `<svg xmlns="http://www.w3.org/2000/svg" width="0" height="0" style="position:absolute" aria-hidden="true">` +
+		`<symbol id="iconoir-outline-icon" viewBox="0 0 24 24"><g fill="none" stroke="currentColor"><path d="M3 3h18"/></g></symbol>` +
+		`<symbol id="iconoir-masked-icon" viewBox="0 0 24 24"><defs><path id="iconoir-masked-icon-p0" d="M0 0"/></defs><use href="#iconoir-masked-icon-p0"/></symbol>` +
+		`</svg>`
+	if sb.String() != expected {
+		t.Errorf("SpriteSheet() = %q, want %q", sb.String(), expected)
+	}
+}
+
+func TestSprite_SpriteSheetKeepsNonDefaultStrokeWidths(t *testing.T) {
+	result := makeSpriteSheet([]*Icon{Frame})
+	if strings.Contains(result, `stroke-width="1.5"`) {
+		t.Errorf("makeSpriteSheet() = %q, expected the default stroke widths to be stripped", result)
+	}
+	if !strings.Contains(result, `stroke-width="1.22"`) {
+		t.Errorf("makeSpriteSheet() = %q, expected the stroke-width=\"1.22\" of frame to be kept", result)
+	}
+}
+
+func TestSprite_SpriteSheetUnknownIcon(t *testing.T) {
+	result := makeSpriteSheet([]*Icon{{Name: "non-existing-icon", Size: "24"}})
+	if !strings.Contains(result, `<!-- Error: icon not found: 'non-existing-icon' -->`) {
+		t.Errorf("makeSpriteSheet() = %q, expected an error comment", result)
+	}
+}
+
+func TestSprite_RenderSprite(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(b *IconBuilder) *IconBuilder
+		expected string
+	}{
+		{
+			name:     "Default sprite reference",
+			setup:    func(b *IconBuilder) *IconBuilder { return b },
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" aria-hidden="true"><use href="#iconoir-check-circle"/></svg>`,
+		},
+		{
+			name: "Size, color, stroke width and attributes are honored",
+			setup: func(b *IconBuilder) *IconBuilder {
+				return b.SetSize(32).
+					SetColor("#2dd4bf").
+					SetStrokeWidth("2").
+					SetAttrs(templ.Attributes{"class": "icon"})
+			},
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="32" height="32" viewBox="0 0 24 24" fill="none" stroke-width="2" color="#2dd4bf" aria-hidden="true" class="icon"><use href="#iconoir-check-circle"/></svg>`,
+		},
+		{
+			name:     "Labelled sprite reference",
+			setup:    func(b *IconBuilder) *IconBuilder { return b.SetIDPrefix("ok-").SetLabel("Done") },
+			expected: `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke-width="1.5" role="img" aria-labelledby="ok-title"><title id="ok-title">Done</title><use href="#iconoir-check-circle"/></svg>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := tt.setup(CheckCircle.Config().SetRenderMode(RenderSprite))
+
+			var sb strings.Builder
+			if err := builder.Render(context.Background(), &sb); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if sb.String() != tt.expected {
+				t.Errorf("Render() = %q, want %q", sb.String(), tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package templiconoir
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// iconTransform holds the Iconify transformations of an icon or alias.
+type iconTransform struct {
+	Rotate int // Quarter turns, clockwise
+	HFlip  bool
+	VFlip  bool
+}
+
+// parseTransform reads the `rotate`, `hFlip` and `vFlip` properties of an Iconify entry.
+func parseTransform(value gjson.Result) iconTransform {
+	return iconTransform{
+		Rotate: int(value.Get("rotate").Int()),
+		HFlip:  value.Get("hFlip").Bool(),
+		VFlip:  value.Get("vFlip").Bool(),
+	}
+}
+
+// merge combines two transformations, following the Iconify rules.
+func (t iconTransform) merge(other iconTransform) iconTransform {
+	return iconTransform{
+		Rotate: (t.Rotate + other.Rotate) % 4,
+		HFlip:  t.HFlip != other.HFlip,
+		VFlip:  t.VFlip != other.VFlip,
+	}
+}
+
+// isZero reports whether the transformation leaves the icon unchanged.
+func (t iconTransform) isZero() bool {
+	return t.Rotate%4 == 0 && !t.HFlip && !t.VFlip
+}
+
+// apply wraps the body of icon in a <g> element applying the transformation within
+// the viewBox of the icon, following the Iconify rules. Quarter turns swap the
+// dimensions of the viewBox.
+func (t iconTransform) apply(icon IconData) IconData {
+	if t.isZero() {
+		return icon
+	}
+
+	rotate := t.Rotate
+	var transforms []string
+	switch {
+	case t.HFlip && t.VFlip:
+		// Flipping both ways is a half turn
+		rotate += 2
+	case t.HFlip:
+		transforms = append(transforms, "translate("+formatNumber(icon.Width+icon.Left)+" "+formatNumber(0-icon.Top)+")", "scale(-1 1)")
+		icon.Left, icon.Top = 0, 0
+	case t.VFlip:
+		transforms = append(transforms, "translate("+formatNumber(0-icon.Left)+" "+formatNumber(icon.Height+icon.Top)+")", "scale(1 -1)")
+		icon.Left, icon.Top = 0, 0
+	}
+
+	// Rotations are applied after the flips, so they come first in the transform list
+	switch rotate % 4 {
+	case 1:
+		center := formatNumber(icon.Height/2 + icon.Top)
+		transforms = append([]string{"rotate(90 " + center + " " + center + ")"}, transforms...)
+	case 2:
+		transforms = append([]string{"rotate(180 " + formatNumber(icon.Width/2+icon.Left) + " " + formatNumber(icon.Height/2+icon.Top) + ")"}, transforms...)
+	case 3:
+		center := formatNumber(icon.Width/2 + icon.Left)
+		transforms = append([]string{"rotate(-90 " + center + " " + center + ")"}, transforms...)
+	}
+	if rotate%2 == 1 {
+		icon.Left, icon.Top = icon.Top, icon.Left
+		icon.Width, icon.Height = icon.Height, icon.Width
+	}
+
+	if len(transforms) == 0 {
+		return icon
+	}
+	icon.Body = `<g transform="` + strings.Join(transforms, " ") + `">` + icon.Body + `</g>`
+	return icon
+}
+
+// formatNumber formats a number using the shortest representation.
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// appendNumber appends a number using the shortest representation.
+func appendNumber(dst []byte, value float64) []byte {
+	return strconv.AppendFloat(dst, value, 'f', -1, 64)
+}
+
+// resolveAlias follows the alias chain of name up to an icon, returning the icon name
+// and the combined transformation of the aliases. It fails on circular aliases.
+func resolveAlias(name string, aliases map[string]gjson.Result) (string, iconTransform, bool) {
+	var transform iconTransform
+	for hops := 0; hops <= len(aliases); hops++ {
+		alias, isAlias := aliases[name]
+		if !isAlias {
+			return name, transform, true
+		}
+		transform = parseTransform(alias).merge(transform)
+		name = alias.Get("parent").String()
+	}
+	return "", transform, false
+}
@@ -0,0 +1,265 @@
+package templiconoir
+
+import (
+	"testing"
+
+	"github.com/tidwall/gjson"
+)
+
+func TestTransform_apply(t *testing.T) {
+	body := `<path d="M0 0"/>`
+
+	tests := []struct {
+		name      string
+		transform iconTransform
+		expected  string
+	}{
+		{
+			name:      "No transformation",
+			transform: iconTransform{},
+			expected:  `<path d="M0 0"/>`,
+		},
+		{
+			name:      "Horizontal flip",
+			transform: iconTransform{HFlip: true},
+			expected:  `<g transform="translate(24 0) scale(-1 1)"><path d="M0 0"/></g>`,
+		},
+		{
+			name:      "Vertical flip",
+			transform: iconTransform{VFlip: true},
+			expected:  `<g transform="translate(0 24) scale(1 -1)"><path d="M0 0"/></g>`,
+		},
+		{
+			name:      "Quarter turn",
+			transform: iconTransform{Rotate: 1},
+			expected:  `<g transform="rotate(90 12 12)"><path d="M0 0"/></g>`,
+		},
+		{
+			name:      "Both flips are a half turn",
+			transform: iconTransform{HFlip: true, VFlip: true},
+			expected:  `<g transform="rotate(180 12 12)"><path d="M0 0"/></g>`,
+		},
+		{
+			name:      "Three quarter turns and a flip",
+			transform: iconTransform{Rotate: 3, HFlip: true},
+			expected:  `<g transform="rotate(-90 12 12) translate(24 0) scale(-1 1)"><path d="M0 0"/></g>`,
+		},
+		{
+			name:      "Full turn and both flips cancel out",
+			transform: iconTransform{Rotate: 2, HFlip: true, VFlip: true},
+			expected:  `<path d="M0 0"/>`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable for parallel tests.
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel() // Run test in parallel.
+
+			icon := IconData{Body: body, Width: 24, Height: 24}
+			if result := tt.transform.apply(icon).Body; result != tt.expected {
+				t.Errorf("apply() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTransform_applyViewBox(t *testing.T) {
+	icon := IconData{Body: `<path d="M0 0"/>`, Left: 2, Top: 1, Width: 20, Height: 10}
+
+	tests := []struct {
+		name            string
+		transform       iconTransform
+		expectedBody    string
+		expectedViewBox string
+	}{
+		{
+			name:            "Horizontal flip",
+			transform:       iconTransform{HFlip: true},
+			expectedBody:    `<g transform="translate(22 -1) scale(-1 1)"><path d="M0 0"/></g>`,
+			expectedViewBox: "0 0 20 10",
+		},
+		{
+			name:            "Quarter turn swaps the dimensions",
+			transform:       iconTransform{Rotate: 1},
+			expectedBody:    `<g transform="rotate(90 6 6)"><path d="M0 0"/></g>`,
+			expectedViewBox: "1 2 10 20",
+		},
+		{
+			name:            "Half turn keeps the dimensions",
+			transform:       iconTransform{Rotate: 2},
+			expectedBody:    `<g transform="rotate(180 12 6)"><path d="M0 0"/></g>`,
+			expectedViewBox: "2 1 20 10",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable for parallel tests.
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel() // Run test in parallel.
+
+			result := tt.transform.apply(icon)
+			if result.Body != tt.expectedBody {
+				t.Errorf("apply().Body = %q, want %q", result.Body, tt.expectedBody)
+			}
+			if result.viewBox() != tt.expectedViewBox {
+				t.Errorf("apply().viewBox() = %q, want %q", result.viewBox(), tt.expectedViewBox)
+			}
+		})
+	}
+}
+
+func TestTransform_resolveAlias(t *testing.T) {
+	aliases := map[string]gjson.Result{}
+	gjson.Parse(`{
+		"arrow-left": {"parent": "arrow-right", "hFlip": true},
+		"arrow-down": {"parent": "arrow-right", "rotate": 1},
+		"arrow-up": {"parent": "arrow-down", "rotate": 2},
+		"loop-a": {"parent": "loop-b"},
+		"loop-b": {"parent": "loop-a"}
+	}`).ForEach(func(key, value gjson.Result) bool {
+		aliases[key.String()] = value
+		return true
+	})
+
+	tests := []struct {
+		name              string
+		alias             string
+		expectedParent    string
+		expectedTransform iconTransform
+		ok                bool
+	}{
+		{name: "Icon name", alias: "arrow-right", expectedParent: "arrow-right", ok: true},
+		{name: "Flipped alias", alias: "arrow-left", expectedParent: "arrow-right", expectedTransform: iconTransform{HFlip: true}, ok: true},
+		{name: "Alias of an alias", alias: "arrow-up", expectedParent: "arrow-right", expectedTransform: iconTransform{Rotate: 3}, ok: true},
+		{name: "Circular alias", alias: "loop-a", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parent, transform, ok := resolveAlias(tt.alias, aliases)
+			if ok != tt.ok {
+				t.Fatalf("resolveAlias() ok = %v, want %v", ok, tt.ok)
+			}
+			if !ok {
+				return
+			}
+			if parent != tt.expectedParent {
+				t.Errorf("resolveAlias() parent = %q, want %q", parent, tt.expectedParent)
+			}
+			if transform != tt.expectedTransform {
+				t.Errorf("resolveAlias() transform = %+v, want %+v", transform, tt.expectedTransform)
+			}
+		})
+	}
+}
+
+func TestTransform_AliasBodies(t *testing.T) {
+	source := NewBytesSource([]byte(`{
+		"width": 24,
+		"height": 24,
+		"icons": {"arrow-right": {"body": "<path d='M0 0'/>"}},
+		"aliases": {
+			"arrow-right-alt": {"parent": "arrow-right"},
+			"arrow-left": {"parent": "arrow-right", "hFlip": true},
+			"broken": {"parent": "missing"}
+		}
+	}`))
+
+	tests := []struct {
+		name           string
+		iconName       string
+		expectedBody   string
+		expectingError bool
+	}{
+		{name: "Plain alias", iconName: "arrow-right-alt", expectedBody: "<path d='M0 0'/>"},
+		{name: "Flipped alias", iconName: "arrow-left", expectedBody: `<g transform="translate(24 0) scale(-1 1)"><path d='M0 0'/></g>`},
+		{name: "Alias with a missing parent", iconName: "broken", expectingError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := source.Body(tt.iconName)
+			if tt.expectingError {
+				if err == nil {
+					t.Errorf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if body != tt.expectedBody {
+				t.Errorf("Body() = %q, want %q", body, tt.expectedBody)
+			}
+		})
+	}
+}
+
+func TestTransform_IconBodies(t *testing.T) {
+	source := NewBytesSource([]byte(`{
+		"width": 10,
+		"height": 20,
+		"icons": {
+			"tall": {"body": "<path d='M0 0'/>", "rotate": 1},
+			"mirrored": {"body": "<path d='M0 0'/>", "hFlip": true}
+		},
+		"aliases": {
+			"tall-upside-down": {"parent": "tall", "rotate": 1},
+			"mirrored-back": {"parent": "mirrored", "hFlip": true}
+		}
+	}`))
+
+	tests := []struct {
+		name         string
+		iconName     string
+		expectedIcon IconData
+	}{
+		{
+			name:         "Rotated icon",
+			iconName:     "tall",
+			expectedIcon: IconData{Body: `<g transform="rotate(90 10 10)"><path d='M0 0'/></g>`, Width: 20, Height: 10},
+		},
+		{
+			name:         "Flipped icon",
+			iconName:     "mirrored",
+			expectedIcon: IconData{Body: `<g transform="translate(10 0) scale(-1 1)"><path d='M0 0'/></g>`, Width: 10, Height: 20},
+		},
+		{
+			name:         "Alias rotating a rotated icon",
+			iconName:     "tall-upside-down",
+			expectedIcon: IconData{Body: `<g transform="rotate(180 5 10)"><path d='M0 0'/></g>`, Width: 10, Height: 20},
+		},
+		{
+			name:         "Alias flipping a flipped icon back",
+			iconName:     "mirrored-back",
+			expectedIcon: IconData{Body: "<path d='M0 0'/>", Width: 10, Height: 20},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			icon, err := source.Icon(tt.iconName)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if icon != tt.expectedIcon {
+				t.Errorf("Icon() = %+v, want %+v", icon, tt.expectedIcon)
+			}
+		})
+	}
+}
+
+func TestTransform_AliasBodiesRealData(t *testing.T) {
+	aliasBody, err := defaultSource.Body("1st-medal")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	parentBody, err := defaultSource.Body("medal-1st")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if aliasBody != parentBody {
+		t.Errorf("Body(\"1st-medal\") = %q, want %q", aliasBody, parentBody)
+	}
+}